@@ -0,0 +1,122 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"log"
+	"net/http"
+	"strings"
+)
+
+func (c *Client) GetBackupConfiguration(ctx context.Context, serverId string, backupConfigurationId string) (*BackupConfiguration, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/servers/%s/backup-configs/%s", c.HostURL, serverId, backupConfigurationId), nil)
+	log.Printf("[INFO] [LARAVELFORGE:GetBackupConfiguration] BackupConfigurationId: %s", backupConfigurationId)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err, _ := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	backupConfiguration := BackupConfigurationResponse{}
+	err = json.Unmarshal(body, &backupConfiguration)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("[INFO] [LARAVELFORGE:GetBackupConfiguration] Backup Configuration: %#v, Body: %#v", &backupConfiguration, body)
+
+	return &backupConfiguration.Backup, nil
+}
+
+func (c *Client) ListBackupConfigurations(ctx context.Context, serverId string) ([]BackupConfiguration, diag.Diagnostics) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/servers/%s/backup-configs", c.HostURL, serverId), nil)
+	if err != nil {
+		return nil, diag.Errorf("Whoops: %s", err)
+	}
+
+	body, err, _ := c.doRequest(req)
+	log.Printf("[DEBUG] [ListBackupConfigurations] - body: %#v, Server ID: %s", string(body), serverId)
+	if err != nil {
+		return nil, diag.Errorf("Whoops: %s", err)
+	}
+
+	var backupConfigurationsResponse BackupConfigurationsResponse
+	err = json.Unmarshal(body, &backupConfigurationsResponse)
+	if err != nil {
+		return nil, diag.Errorf("Whoops: %s", err)
+	}
+
+	return backupConfigurationsResponse.Backups, nil
+}
+
+func (c *Client) CreateBackupConfiguration(ctx context.Context, serverId string, createBackupConfiguration *BackupConfigurationRequest) (*BackupConfiguration, diag.Diagnostics) {
+	log.Printf("[INFO] [LARAVELFORGE:CreateBackupConfiguration]")
+	rb, err := json.Marshal(createBackupConfiguration)
+	if err != nil {
+		return nil, diag.Errorf("Whoops: %s", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/servers/%s/backup-configs", c.HostURL, serverId), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, diag.Errorf("Whoops: %s", err)
+	}
+
+	body, err, _ := c.doRequest(req)
+	if err != nil {
+		return nil, diag.Errorf("Whoopsy: %s", err)
+	}
+
+	backupConfiguration := BackupConfigurationResponse{}
+	err = json.Unmarshal(body, &backupConfiguration)
+	if err != nil {
+		return nil, diag.Errorf("Whoops: %s", err)
+	}
+
+	return &backupConfiguration.Backup, nil
+}
+
+func (c *Client) UpdateBackupConfiguration(ctx context.Context, serverId string, backupConfigurationId string, backupConfigurationUpdates BackupConfigurationRequest) (*BackupConfiguration, diag.Diagnostics) {
+	rb, err := json.Marshal(backupConfigurationUpdates)
+	if err != nil {
+		return nil, diag.Errorf("Whoops: %s", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("%s/servers/%s/backup-configs/%s", c.HostURL, serverId, backupConfigurationId), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, diag.Errorf("Whoops: %s", err)
+	}
+
+	body, err, _ := c.doRequest(req)
+	if err != nil {
+		return nil, diag.Errorf("Whoops: %s", err)
+	}
+
+	backupConfiguration := BackupConfigurationResponse{}
+	err = json.Unmarshal(body, &backupConfiguration)
+	if err != nil {
+		return nil, diag.Errorf("Whoops: %s", err)
+	}
+
+	return &backupConfiguration.Backup, nil
+}
+
+func (c *Client) DeleteBackupConfiguration(ctx context.Context, serverId string, backupConfigurationId string) error {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/servers/%s/backup-configs/%s", c.HostURL, serverId, backupConfigurationId), nil)
+	if err != nil {
+		return err
+	}
+	body, err, _ := c.doRequest(req)
+	if err != nil {
+		return err
+	}
+
+	if string(body) != "" {
+		return errors.New(string(body))
+	}
+
+	return nil
+}
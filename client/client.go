@@ -1,9 +1,13 @@
 package client
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
-	"io"
 	"net/http"
+	"net/url"
+	"strings"
 	"time"
 )
 
@@ -11,54 +15,84 @@ import (
 const HostURL string = "https://forge.laravel.com/api/v1"
 
 type Client struct {
-	HostURL    string
-	HTTPClient *http.Client
-	Token      string
+	HostURL      string
+	HTTPClient   *http.Client
+	Token        string
+	MaxRetries   int
+	RetryMaxWait time.Duration
+	limiter      *rateLimiter
 }
 
 func NewClient(host, token *string) (*Client, error) {
 	c := Client{
-		HTTPClient: &http.Client{Timeout: 10 * time.Second},
-		HostURL:    HostURL,
-		Token:      *token,
+		HTTPClient:   &http.Client{Timeout: 10 * time.Second},
+		HostURL:      HostURL,
+		Token:        *token,
+		MaxRetries:   DefaultMaxRetries,
+		RetryMaxWait: DefaultRetryMaxWait,
+		limiter:      newRateLimiter(DefaultRequestsPerMinute),
 	}
 
-	if host != nil {
-		c.HostURL = *host
+	if host != nil && *host != "" {
+		c.HostURL = strings.TrimSuffix(*host, "/")
 	}
 
 	return &c, nil
 }
 
+// SetRequestTimeout - Sets the time limit for a single request to the API
+func (c *Client) SetRequestTimeout(timeout time.Duration) {
+	c.HTTPClient.Timeout = timeout
+}
+
+// SetProxyURL - Sends all requests to the API through the given proxy
+func (c *Client) SetProxyURL(proxyURL string) error {
+	u, err := url.Parse(proxyURL)
+	if err != nil {
+		return err
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = http.ProxyURL(u)
+	c.HTTPClient.Transport = transport
+
+	return nil
+}
+
 const logRespMsg = `DEBUG: Response %s/%s Details:
 ---[ RESPONSE ]--------------------------------------
 %s
 -----------------------------------------------------`
 
+// RequestError - Returned when the API responds with an unexpected status code
+type RequestError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *RequestError) Error() string {
+	return fmt.Sprintf("status: %d, body: %s", e.StatusCode, e.Body)
+}
+
+// IsNotFound - Whether the API responded that the requested entity doesn't exist
+func IsNotFound(err error) bool {
+	var requestErr *RequestError
+
+	return errors.As(err, &requestErr) && requestErr.StatusCode == http.StatusNotFound
+}
+
 func (c *Client) doRequest(req *http.Request) ([]byte, error, *http.Response) {
 	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.Token))
 	req.Header.Set("Accept", "application/json")
 	req.Header.Set("Content-Type", "application/json")
 
-	res, err := c.HTTPClient.Do(req)
+	res, body, err := c.send(req)
 	if err != nil {
 		return nil, err, res
 	}
-	defer res.Body.Close()
-
-	body, err := io.ReadAll(res.Body)
-	if err != nil {
-		return nil, err, res
-	}
-
-	if res.StatusCode == http.StatusTooManyRequests {
-		time.Sleep(time.Second * 30)
-
-		return c.doRequest(req)
-	}
 
 	if res.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("status: %d, body: %s", res.StatusCode, body), res
+		return nil, &RequestError{StatusCode: res.StatusCode, Body: string(body)}, res
 	}
 
 	return body, err, res
@@ -69,11 +103,10 @@ func (c *Client) doRequestEmptyBody(req *http.Request) error {
 	req.Header.Set("Accept", "application/json")
 	req.Header.Set("Content-Type", "application/json")
 
-	res, err := c.HTTPClient.Do(req)
+	res, _, err := c.send(req)
 	if err != nil {
 		return err
 	}
-	defer res.Body.Close()
 
 	if res.StatusCode != http.StatusOK {
 		return fmt.Errorf("status: %d", res.StatusCode)
@@ -81,3 +114,26 @@ func (c *Client) doRequestEmptyBody(req *http.Request) error {
 
 	return err
 }
+
+// SleepContext - Waits for the given duration, or until the context is done
+func SleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// decodeTextBody - Some endpoints return plain text while others wrap the text in a JSON string
+func decodeTextBody(body []byte) string {
+	var text string
+	if err := json.Unmarshal(body, &text); err == nil {
+		return text
+	}
+
+	return string(body)
+}
@@ -1,6 +1,7 @@
 package client
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -10,8 +11,8 @@ import (
 	"strings"
 )
 
-func (c *Client) GetDaemon(serverId string, daemonId string) (*Daemon, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/servers/%s/daemons/%s", c.HostURL, serverId, daemonId), nil)
+func (c *Client) GetDaemon(ctx context.Context, serverId string, daemonId string) (*Daemon, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/servers/%s/daemons/%s", c.HostURL, serverId, daemonId), nil)
 	log.Printf("[INFO] [LARAVELFORGE:GetDaemon] DaemonId: %s", daemonId)
 	if err != nil {
 		return nil, err
@@ -32,14 +33,14 @@ func (c *Client) GetDaemon(serverId string, daemonId string) (*Daemon, error) {
 	return &daemon.Daemon, nil
 }
 
-func (c *Client) CreateDaemon(serverId string, createDaemon *CreateDaemonRequest) (*Daemon, diag.Diagnostics) {
+func (c *Client) CreateDaemon(ctx context.Context, serverId string, createDaemon *CreateDaemonRequest) (*Daemon, diag.Diagnostics) {
 	log.Printf("[INFO] [LARAVELFORGE:CreateDaemon]")
 	rb, err := json.Marshal(createDaemon)
 	if err != nil {
 		return nil, diag.Errorf("Whoops: %s", err)
 	}
 
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/servers/%s/daemons", c.HostURL, serverId), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/servers/%s/daemons", c.HostURL, serverId), strings.NewReader(string(rb)))
 	if err != nil {
 		return nil, diag.Errorf("Whoops: %s", err)
 	}
@@ -58,8 +59,8 @@ func (c *Client) CreateDaemon(serverId string, createDaemon *CreateDaemonRequest
 	return &daemon.Daemon, nil
 }
 
-func (c *Client) DeleteDaemon(serverId string, daemonId string) error {
-	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/servers/%s/daemons/%s", c.HostURL, serverId, daemonId), nil)
+func (c *Client) DeleteDaemon(ctx context.Context, serverId string, daemonId string) error {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/servers/%s/daemons/%s", c.HostURL, serverId, daemonId), nil)
 	if err != nil {
 		return err
 	}
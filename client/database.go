@@ -0,0 +1,98 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"log"
+	"net/http"
+	"strings"
+)
+
+func (c *Client) GetDatabase(ctx context.Context, serverId string, databaseId string) (*Database, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/servers/%s/databases/%s", c.HostURL, serverId, databaseId), nil)
+	log.Printf("[INFO] [LARAVELFORGE:GetDatabase] DatabaseId: %s", databaseId)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err, _ := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	database := DatabaseResponse{}
+	err = json.Unmarshal(body, &database)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("[INFO] [LARAVELFORGE:GetDatabase] Database: %#v, Body: %#v", &database, body)
+
+	return &database.Database, nil
+}
+
+func (c *Client) ListDatabases(ctx context.Context, serverId string) ([]Database, diag.Diagnostics) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/servers/%s/databases", c.HostURL, serverId), nil)
+	if err != nil {
+		return nil, diag.Errorf("Whoops: %s", err)
+	}
+
+	body, err, _ := c.doRequest(req)
+	log.Printf("[DEBUG] [ListDatabases] - body: %#v, Server ID: %s", string(body), serverId)
+	if err != nil {
+		return nil, diag.Errorf("Whoops: %s", err)
+	}
+
+	var databasesResponse DatabasesResponse
+	err = json.Unmarshal(body, &databasesResponse)
+	if err != nil {
+		return nil, diag.Errorf("Whoops: %s", err)
+	}
+
+	return databasesResponse.Databases, nil
+}
+
+func (c *Client) CreateDatabase(ctx context.Context, serverId string, createDatabase *CreateDatabaseRequest) (*Database, diag.Diagnostics) {
+	log.Printf("[INFO] [LARAVELFORGE:CreateDatabase]")
+	rb, err := json.Marshal(createDatabase)
+	if err != nil {
+		return nil, diag.Errorf("Whoops: %s", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/servers/%s/databases", c.HostURL, serverId), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, diag.Errorf("Whoops: %s", err)
+	}
+
+	body, err, _ := c.doRequest(req)
+	if err != nil {
+		return nil, diag.Errorf("Whoopsy: %s", err)
+	}
+
+	database := DatabaseResponse{}
+	err = json.Unmarshal(body, &database)
+	if err != nil {
+		return nil, diag.Errorf("Whoops: %s", err)
+	}
+
+	return &database.Database, nil
+}
+
+func (c *Client) DeleteDatabase(ctx context.Context, serverId string, databaseId string) error {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/servers/%s/databases/%s", c.HostURL, serverId, databaseId), nil)
+	if err != nil {
+		return err
+	}
+	body, err, _ := c.doRequest(req)
+	if err != nil {
+		return err
+	}
+
+	if string(body) != "" {
+		return errors.New(string(body))
+	}
+
+	return nil
+}
@@ -0,0 +1,122 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"log"
+	"net/http"
+	"strings"
+)
+
+func (c *Client) GetDatabaseUser(ctx context.Context, serverId string, userId string) (*DatabaseUser, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/servers/%s/database-users/%s", c.HostURL, serverId, userId), nil)
+	log.Printf("[INFO] [LARAVELFORGE:GetDatabaseUser] UserId: %s", userId)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err, _ := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	user := DatabaseUserResponse{}
+	err = json.Unmarshal(body, &user)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("[INFO] [LARAVELFORGE:GetDatabaseUser] User: %#v, Body: %#v", &user, body)
+
+	return &user.User, nil
+}
+
+func (c *Client) ListDatabaseUsers(ctx context.Context, serverId string) ([]DatabaseUser, diag.Diagnostics) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/servers/%s/database-users", c.HostURL, serverId), nil)
+	if err != nil {
+		return nil, diag.Errorf("Whoops: %s", err)
+	}
+
+	body, err, _ := c.doRequest(req)
+	log.Printf("[DEBUG] [ListDatabaseUsers] - body: %#v, Server ID: %s", string(body), serverId)
+	if err != nil {
+		return nil, diag.Errorf("Whoops: %s", err)
+	}
+
+	var usersResponse DatabaseUsersResponse
+	err = json.Unmarshal(body, &usersResponse)
+	if err != nil {
+		return nil, diag.Errorf("Whoops: %s", err)
+	}
+
+	return usersResponse.Users, nil
+}
+
+func (c *Client) CreateDatabaseUser(ctx context.Context, serverId string, createUser *CreateDatabaseUserRequest) (*DatabaseUser, diag.Diagnostics) {
+	log.Printf("[INFO] [LARAVELFORGE:CreateDatabaseUser]")
+	rb, err := json.Marshal(createUser)
+	if err != nil {
+		return nil, diag.Errorf("Whoops: %s", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/servers/%s/database-users", c.HostURL, serverId), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, diag.Errorf("Whoops: %s", err)
+	}
+
+	body, err, _ := c.doRequest(req)
+	if err != nil {
+		return nil, diag.Errorf("Whoopsy: %s", err)
+	}
+
+	user := DatabaseUserResponse{}
+	err = json.Unmarshal(body, &user)
+	if err != nil {
+		return nil, diag.Errorf("Whoops: %s", err)
+	}
+
+	return &user.User, nil
+}
+
+func (c *Client) UpdateDatabaseUser(ctx context.Context, serverId string, userId string, userUpdates UpdateDatabaseUserRequest) (*DatabaseUser, diag.Diagnostics) {
+	rb, err := json.Marshal(userUpdates)
+	if err != nil {
+		return nil, diag.Errorf("Whoops: %s", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("%s/servers/%s/database-users/%s", c.HostURL, serverId, userId), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, diag.Errorf("Whoops: %s", err)
+	}
+
+	body, err, _ := c.doRequest(req)
+	if err != nil {
+		return nil, diag.Errorf("Whoops: %s", err)
+	}
+
+	user := DatabaseUserResponse{}
+	err = json.Unmarshal(body, &user)
+	if err != nil {
+		return nil, diag.Errorf("Whoops: %s", err)
+	}
+
+	return &user.User, nil
+}
+
+func (c *Client) DeleteDatabaseUser(ctx context.Context, serverId string, userId string) error {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/servers/%s/database-users/%s", c.HostURL, serverId, userId), nil)
+	if err != nil {
+		return err
+	}
+	body, err, _ := c.doRequest(req)
+	if err != nil {
+		return err
+	}
+
+	if string(body) != "" {
+		return errors.New(string(body))
+	}
+
+	return nil
+}
@@ -0,0 +1,138 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"log"
+	"net/http"
+	"strings"
+)
+
+func (c *Client) GetDeploymentScript(ctx context.Context, serverId string, siteId string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/servers/%s/sites/%s/deployment/script", c.HostURL, serverId, siteId), nil)
+	log.Printf("[INFO] [LARAVELFORGE:GetDeploymentScript] SiteId: %s", siteId)
+	if err != nil {
+		return "", err
+	}
+
+	body, err, _ := c.doRequest(req)
+	if err != nil {
+		return "", err
+	}
+
+	return decodeTextBody(body), nil
+}
+
+func (c *Client) UpdateDeploymentScript(ctx context.Context, serverId string, siteId string, scriptUpdate DeploymentScriptUpdateRequest) diag.Diagnostics {
+	log.Printf("[INFO] [LARAVELFORGE:UpdateDeploymentScript] SiteId: %s", siteId)
+	rb, err := json.Marshal(scriptUpdate)
+	if err != nil {
+		return diag.Errorf("Whoops: %s", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("%s/servers/%s/sites/%s/deployment/script", c.HostURL, serverId, siteId), strings.NewReader(string(rb)))
+	if err != nil {
+		return diag.Errorf("Whoops: %s", err)
+	}
+
+	err = c.doRequestEmptyBody(req)
+	if err != nil {
+		return diag.Errorf("Whoops: %s", err)
+	}
+
+	return nil
+}
+
+func (c *Client) DeploySite(ctx context.Context, serverId string, siteId string) (*Site, diag.Diagnostics) {
+	log.Printf("[INFO] [LARAVELFORGE:DeploySite] SiteId: %s", siteId)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/servers/%s/sites/%s/deployment/deploy", c.HostURL, serverId, siteId), nil)
+	if err != nil {
+		return nil, diag.Errorf("Whoops: %s", err)
+	}
+
+	body, err, _ := c.doRequest(req)
+	if err != nil {
+		return nil, diag.Errorf("Whoopsy: %s", err)
+	}
+
+	site := SiteGet{}
+	err = json.Unmarshal(body, &site)
+	if err != nil {
+		return nil, diag.Errorf("Whoops: %s", err)
+	}
+
+	return &site.Site, nil
+}
+
+func (c *Client) ListDeployments(ctx context.Context, serverId string, siteId string) ([]Deployment, diag.Diagnostics) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/servers/%s/sites/%s/deployment-history", c.HostURL, serverId, siteId), nil)
+	if err != nil {
+		return nil, diag.Errorf("Whoops: %s", err)
+	}
+
+	body, err, _ := c.doRequest(req)
+	log.Printf("[DEBUG] [ListDeployments] - body: %#v, Site ID: %s", string(body), siteId)
+	if err != nil {
+		return nil, diag.Errorf("Whoops: %s", err)
+	}
+
+	var deploymentsResponse DeploymentsResponse
+	err = json.Unmarshal(body, &deploymentsResponse)
+	if err != nil {
+		return nil, diag.Errorf("Whoops: %s", err)
+	}
+
+	return deploymentsResponse.Deployments, nil
+}
+
+func (c *Client) GetDeploymentOutput(ctx context.Context, serverId string, siteId string, deploymentId string) (string, diag.Diagnostics) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/servers/%s/sites/%s/deployment-history/%s/output", c.HostURL, serverId, siteId, deploymentId), nil)
+	log.Printf("[INFO] [LARAVELFORGE:GetDeploymentOutput] DeploymentId: %s", deploymentId)
+	if err != nil {
+		return "", diag.Errorf("Whoops: %s", err)
+	}
+
+	body, err, _ := c.doRequest(req)
+	if err != nil {
+		return "", diag.Errorf("Whoops: %s", err)
+	}
+
+	output := DeploymentOutputResponse{}
+	err = json.Unmarshal(body, &output)
+	if err != nil {
+		return "", diag.Errorf("Whoops: %s", err)
+	}
+
+	return output.Output, nil
+}
+
+func (c *Client) EnableQuickDeploy(ctx context.Context, serverId string, siteId string) diag.Diagnostics {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/servers/%s/sites/%s/deployment", c.HostURL, serverId, siteId), nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = c.doRequestEmptyBody(req)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func (c *Client) DisableQuickDeploy(ctx context.Context, serverId string, siteId string) diag.Diagnostics {
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("%s/servers/%s/sites/%s/deployment", c.HostURL, serverId, siteId), nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = c.doRequestEmptyBody(req)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
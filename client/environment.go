@@ -0,0 +1,46 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"log"
+	"net/http"
+	"strings"
+)
+
+func (c *Client) GetSiteEnvironment(ctx context.Context, serverId string, siteId string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/servers/%s/sites/%s/env", c.HostURL, serverId, siteId), nil)
+	log.Printf("[INFO] [LARAVELFORGE:GetSiteEnvironment] SiteId: %s", siteId)
+	if err != nil {
+		return "", err
+	}
+
+	body, err, _ := c.doRequest(req)
+	if err != nil {
+		return "", err
+	}
+
+	return decodeTextBody(body), nil
+}
+
+func (c *Client) UpdateSiteEnvironment(ctx context.Context, serverId string, siteId string, environmentUpdate EnvironmentUpdateRequest) diag.Diagnostics {
+	log.Printf("[INFO] [LARAVELFORGE:UpdateSiteEnvironment] SiteId: %s", siteId)
+	rb, err := json.Marshal(environmentUpdate)
+	if err != nil {
+		return diag.Errorf("Whoops: %s", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("%s/servers/%s/sites/%s/env", c.HostURL, serverId, siteId), strings.NewReader(string(rb)))
+	if err != nil {
+		return diag.Errorf("Whoops: %s", err)
+	}
+
+	err = c.doRequestEmptyBody(req)
+	if err != nil {
+		return diag.Errorf("Whoops: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,120 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// FirewallPort - Forge returns single ports as numbers and port ranges as strings
+type FirewallPort string
+
+func (p *FirewallPort) UnmarshalJSON(data []byte) error {
+	var port interface{}
+	if err := json.Unmarshal(data, &port); err != nil {
+		return err
+	}
+
+	switch v := port.(type) {
+	case nil:
+		*p = ""
+	case float64:
+		*p = FirewallPort(strconv.FormatFloat(v, 'f', -1, 64))
+	default:
+		*p = FirewallPort(fmt.Sprint(v))
+	}
+
+	return nil
+}
+
+func (c *Client) GetFirewallRule(ctx context.Context, serverId string, ruleId string) (*FirewallRule, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/servers/%s/firewall-rules/%s", c.HostURL, serverId, ruleId), nil)
+	log.Printf("[INFO] [LARAVELFORGE:GetFirewallRule] RuleId: %s", ruleId)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err, _ := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	rule := FirewallRuleResponse{}
+	err = json.Unmarshal(body, &rule)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("[INFO] [LARAVELFORGE:GetFirewallRule] Rule: %#v, Body: %#v", &rule, body)
+
+	return &rule.Rule, nil
+}
+
+func (c *Client) ListFirewallRules(ctx context.Context, serverId string) ([]FirewallRule, diag.Diagnostics) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/servers/%s/firewall-rules", c.HostURL, serverId), nil)
+	if err != nil {
+		return nil, diag.Errorf("Whoops: %s", err)
+	}
+
+	body, err, _ := c.doRequest(req)
+	log.Printf("[DEBUG] [ListFirewallRules] - body: %#v, Server ID: %s", string(body), serverId)
+	if err != nil {
+		return nil, diag.Errorf("Whoops: %s", err)
+	}
+
+	var rulesResponse FirewallRulesResponse
+	err = json.Unmarshal(body, &rulesResponse)
+	if err != nil {
+		return nil, diag.Errorf("Whoops: %s", err)
+	}
+
+	return rulesResponse.Rules, nil
+}
+
+func (c *Client) CreateFirewallRule(ctx context.Context, serverId string, createRule *CreateFirewallRuleRequest) (*FirewallRule, diag.Diagnostics) {
+	log.Printf("[INFO] [LARAVELFORGE:CreateFirewallRule]")
+	rb, err := json.Marshal(createRule)
+	if err != nil {
+		return nil, diag.Errorf("Whoops: %s", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/servers/%s/firewall-rules", c.HostURL, serverId), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, diag.Errorf("Whoops: %s", err)
+	}
+
+	body, err, _ := c.doRequest(req)
+	if err != nil {
+		return nil, diag.Errorf("Whoopsy: %s", err)
+	}
+
+	rule := FirewallRuleResponse{}
+	err = json.Unmarshal(body, &rule)
+	if err != nil {
+		return nil, diag.Errorf("Whoops: %s", err)
+	}
+
+	return &rule.Rule, nil
+}
+
+func (c *Client) DeleteFirewallRule(ctx context.Context, serverId string, ruleId string) error {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/servers/%s/firewall-rules/%s", c.HostURL, serverId, ruleId), nil)
+	if err != nil {
+		return err
+	}
+	body, err, _ := c.doRequest(req)
+	if err != nil {
+		return err
+	}
+
+	if string(body) != "" {
+		return errors.New(string(body))
+	}
+
+	return nil
+}
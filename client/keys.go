@@ -1,6 +1,7 @@
 package client
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -12,8 +13,8 @@ import (
 	"time"
 )
 
-func (c *Client) GetKey(serverId string, keyId string) (*Key, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/servers/%s/keys/%s", c.HostURL, serverId, keyId), nil)
+func (c *Client) GetKey(ctx context.Context, serverId string, keyId string) (*Key, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/servers/%s/keys/%s", c.HostURL, serverId, keyId), nil)
 	log.Printf("[INFO] [LARAVELFORGE:GetKey] KeyID: %s", keyId)
 	if err != nil {
 		return nil, err
@@ -34,14 +35,14 @@ func (c *Client) GetKey(serverId string, keyId string) (*Key, error) {
 	return &key.Key, nil
 }
 
-func (c *Client) CreateKey(serverId string, keyCreateRequest *KeyCreateRequest, retry bool) (*Key, diag.Diagnostics) {
+func (c *Client) CreateKey(ctx context.Context, serverId string, keyCreateRequest *KeyCreateRequest, retry bool) (*Key, diag.Diagnostics) {
 	log.Printf("[INFO] [LARAVELFORGE:CreateKey]")
 	rb, err := json.Marshal(keyCreateRequest)
 	if err != nil {
 		return nil, diag.Errorf("Whoops: %s", err)
 	}
 
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/servers/%s/keys", c.HostURL, serverId), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/servers/%s/keys", c.HostURL, serverId), strings.NewReader(string(rb)))
 	if err != nil {
 		return nil, diag.Errorf("Whoops: %s", err)
 	}
@@ -50,17 +51,17 @@ func (c *Client) CreateKey(serverId string, keyCreateRequest *KeyCreateRequest,
 
 	if err != nil && err.Error() == "status: 422, body: {\"name\":[\"The name has already been taken.\"]}" && keyCreateRequest.Overwrite == true && retry == true {
 		log.Printf("[DEBUG] [CreateKey] Key already exists.]")
-		key, searchedKeyErr := c.SearchKeyByName(serverId, keyCreateRequest.Name)
+		key, searchedKeyErr := c.SearchKeyByName(ctx, serverId, keyCreateRequest.Name)
 		log.Printf("[DEBUG] Searched key: %#v, Server ID: %s", key, serverId)
 
 		if searchedKeyErr != nil {
 			log.Printf("[DEBUG] [CreateKey] error thrown. searchedKeyErr != nil")
-			return nil, diag.Errorf("Whoops: %s", searchedKeyErr)
+			return nil, searchedKeyErr
 		}
 
 		if key != nil {
 			log.Printf("[DEBUG] [CreateKey] about to delete existing key")
-			err := c.DeleteKey(serverId, strconv.Itoa(key.Id))
+			err := c.DeleteKey(ctx, serverId, strconv.Itoa(key.Id))
 
 			if err != nil {
 				log.Printf("[ERROR] [CreateKey] Error deleting key: %s", err)
@@ -69,12 +70,14 @@ func (c *Client) CreateKey(serverId string, keyCreateRequest *KeyCreateRequest,
 		}
 
 		log.Printf("[DEBUG] [CreateKey] wait 10 seconds")
-		time.Sleep(time.Second * 10)
+		if err := SleepContext(ctx, time.Second*10); err != nil {
+			return nil, diag.FromErr(err)
+		}
 		log.Printf("[DEBUG] [CreateKey] waited 10 seconds")
 
 		log.Printf("[DEBUG] [CreateKey] about to create new key")
 
-		return c.CreateKey(serverId, keyCreateRequest, false)
+		return c.CreateKey(ctx, serverId, keyCreateRequest, false)
 	}
 
 	if err != nil {
@@ -90,13 +93,13 @@ func (c *Client) CreateKey(serverId string, keyCreateRequest *KeyCreateRequest,
 	return &key.Key, nil
 }
 
-func (c *Client) UpdateKey() error {
+func (c *Client) UpdateKey(ctx context.Context) error {
 	return nil
 }
 
-func (c *Client) DeleteKey(serverId string, keyId string) error {
+func (c *Client) DeleteKey(ctx context.Context, serverId string, keyId string) error {
 	log.Printf("[DEBUG] [DeleteKey] KeyId: %s, Server ID: %s", keyId, serverId)
-	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/servers/%s/keys/%s", c.HostURL, serverId, keyId), nil)
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/servers/%s/keys/%s", c.HostURL, serverId, keyId), nil)
 	if err != nil {
 		return err
 	}
@@ -112,8 +115,8 @@ func (c *Client) DeleteKey(serverId string, keyId string) error {
 	return nil
 }
 
-func (c *Client) ListKeys(serverId string) ([]Key, diag.Diagnostics) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/servers/%s/keys", c.HostURL, serverId), nil)
+func (c *Client) ListKeys(ctx context.Context, serverId string) ([]Key, diag.Diagnostics) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/servers/%s/keys", c.HostURL, serverId), nil)
 	if err != nil {
 		return nil, diag.Errorf("Whoops: %s", err)
 	}
@@ -136,13 +139,13 @@ func (c *Client) ListKeys(serverId string) ([]Key, diag.Diagnostics) {
 	return keysResponse.Keys, nil
 }
 
-func (c *Client) SearchKeyByName(serverId string, keyName string) (*Key, diag.Diagnostics) {
-	keys, err := c.ListKeys(serverId)
+func (c *Client) SearchKeyByName(ctx context.Context, serverId string, keyName string) (*Key, diag.Diagnostics) {
+	keys, err := c.ListKeys(ctx, serverId)
 
 	log.Printf("[DEBUG] [SearchKeyByName] keys: %#v, Key Name: %s, Server ID: %s", keys, keyName, serverId)
 
 	if err != nil {
-		return nil, diag.Errorf("Whoops: %s", err)
+		return nil, err
 	}
 
 	for _, key := range keys {
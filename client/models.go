@@ -6,46 +6,63 @@ type User struct {
 }
 
 type Server struct {
-	Id               int    `json:"id"`
-	CredentialId     string `json:"credential_id"`
-	Name             string `json:"name"`
-	Type             string `json:"type"`
-	Provider         string `json:"provider"`
-	ProviderId       string `json:"provider_id"`
-	Size             string `json:"size"`
-	Region           string `json:"region"`
-	UbuntuVersion    string `json:"ubuntu_version"`
-	DbStatus         string `json:"db_status"`
-	RedisStatus      string `json:"redis_status"`
-	PhpVersion       string `json:"php_version"`
-	PhpCliVersion    string `json:"php_cli_version"`
-	DatabaseType     string `json:"database_type"`
-	IpAddress        string `json:"ip_address"`
-	SshPort          int    `json:"ssh_port"`
-	PrivateIpAddress string `json:"private_ip_address"`
-	LocalPublicKey   string `json:"local_public_key"`
-	BlackfireStatus  string `json:"blackfire_status"`
-	PapertrailStatus string `json:"papertrail_status"`
-	Revoked          bool   `json:"revoked"`
-	CreatedAt        string `json:"created_at"`
-	IsReady          bool   `json:"is_ready"`
-	//Tags             []interface{} `json:"tags"`
-	//PhpVersions      []struct {
-	//	Id                 int    `json:"id"`
-	//	Version            string `json:"version"`
-	//	Status             string `json:"status"`
-	//	DisplayableVersion string `json:"displayable_version"`
-	//	BinaryName         string `json:"binary_name"`
-	//} `json:"php_versions"`
+	Id               int          `json:"id"`
+	CredentialId     string       `json:"credential_id"`
+	Name             string       `json:"name"`
+	Type             string       `json:"type"`
+	Provider         string       `json:"provider"`
+	ProviderId       string       `json:"provider_id"`
+	Size             string       `json:"size"`
+	Region           string       `json:"region"`
+	UbuntuVersion    string       `json:"ubuntu_version"`
+	DbStatus         string       `json:"db_status"`
+	RedisStatus      string       `json:"redis_status"`
+	PhpVersion       string       `json:"php_version"`
+	PhpCliVersion    string       `json:"php_cli_version"`
+	DatabaseType     string       `json:"database_type"`
+	IpAddress        string       `json:"ip_address"`
+	SshPort          int          `json:"ssh_port"`
+	PrivateIpAddress string       `json:"private_ip_address"`
+	LocalPublicKey   string       `json:"local_public_key"`
+	BlackfireStatus  string       `json:"blackfire_status"`
+	PapertrailStatus string       `json:"papertrail_status"`
+	Revoked          bool         `json:"revoked"`
+	CreatedAt        string       `json:"created_at"`
+	IsReady          bool         `json:"is_ready"`
+	PhpVersions      []PhpVersion `json:"php_versions"`
+	Tags             []ServerTag  `json:"tags"`
 	//Network []int `json:"network"`
 }
 
+type ServerTag struct {
+	Id   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+type PhpVersion struct {
+	Id                 int    `json:"id"`
+	Version            string `json:"version"`
+	Status             string `json:"status"`
+	DisplayableVersion string `json:"displayable_version"`
+	BinaryName         string `json:"binary_name"`
+	UsedAsDefault      bool   `json:"used_as_default"`
+	UsedOnCli          bool   `json:"used_on_cli"`
+}
+
+type PhpVersionRequest struct {
+	Version string `json:"version"`
+}
+
 type ServerResponse struct {
 	Server           Server `json:"server"`
 	ProvisionCommand string `json:"provision_command"`
 	SudoPassword     string `json:"sudo_password"`
 }
 
+type ServersResponse struct {
+	Servers []Server `json:"servers"`
+}
+
 type ServerCreateRequest struct {
 	Name             string `json:"name"`
 	Provider         string `json:"provider"`
@@ -73,23 +90,36 @@ type SiteGet struct {
 }
 
 type Site struct {
-	ID          int           `json:"id"`
-	Name        string        `json:"name"`
-	Username    string        `json:"username"`
-	Directory   string        `json:"directory"`
-	Wildcards   bool          `json:"wildcards"`
-	Status      string        `json:"status"`
-	ProjectType string        `json:"project_type"`
-	CreatedAt   string        `json:"created_at"`
-	Network     []interface{} `json:"network"`
+	ID                 int      `json:"id"`
+	Name               string   `json:"name"`
+	Username           string   `json:"username"`
+	Directory          string   `json:"directory"`
+	Aliases            []string `json:"aliases"`
+	Wildcards          bool     `json:"wildcards"`
+	Status             string   `json:"status"`
+	PhpVersion         string   `json:"php_version"`
+	Repository         string   `json:"repository"`
+	RepositoryProvider string   `json:"repository_provider"`
+	RepositoryBranch   string   `json:"repository_branch"`
+	RepositoryStatus   string   `json:"repository_status"`
+	DeploymentStatus   string   `json:"deployment_status"`
+	QuickDeploy        bool     `json:"quick_deploy"`
+	ProjectType        string   `json:"project_type"`
+	CreatedAt          string   `json:"created_at"`
+	Network            []int    `json:"network"`
+}
+
+type SitesResponse struct {
+	Sites []Site `json:"sites"`
 }
 
 type SiteCreateRequest struct {
-	Domain      string `json:"domain"`
-	ProjectType string `json:"project_type"`
-	Directory   string `json:"directory"`
-	Username    string `json:"username"`
-	PhpVersion  string `json:"php_version"`
+	Domain        string `json:"domain"`
+	ProjectType   string `json:"project_type"`
+	Directory     string `json:"directory"`
+	Username      string `json:"username"`
+	PhpVersion    string `json:"php_version"`
+	NginxTemplate int    `json:"nginx_template,omitempty"`
 }
 
 type SiteUpdateRequest struct {
@@ -104,6 +134,17 @@ type SiteUpdatePhpVersion struct {
 	Version string `json:"version"`
 }
 
+type SiteRepositoryInstallRequest struct {
+	Provider   string `json:"provider"`
+	Repository string `json:"repository"`
+	Branch     string `json:"branch"`
+	Composer   bool   `json:"composer"`
+}
+
+type SiteRepositoryBranchUpdateRequest struct {
+	Branch string `json:"branch"`
+}
+
 type SiteItem struct {
 	Provider         string `json:"provider"`
 	Type             string `json:"type"`
@@ -252,3 +293,318 @@ type CreateRedirectRuleRequest struct {
 	To   string `json:"to"`
 	Type string `json:"type"`
 }
+
+type Database struct {
+	Id        int    `json:"id"`
+	Name      string `json:"name"`
+	Status    string `json:"status"`
+	CreatedAt string `json:"created_at"`
+}
+
+type DatabaseResponse struct {
+	Database Database `json:"database"`
+}
+
+type DatabasesResponse struct {
+	Databases []Database `json:"databases"`
+}
+
+type CreateDatabaseRequest struct {
+	Name     string `json:"name"`
+	User     string `json:"user,omitempty"`
+	Password string `json:"password,omitempty"`
+}
+
+type DatabaseUser struct {
+	Id        int    `json:"id"`
+	Name      string `json:"name"`
+	Status    string `json:"status"`
+	CreatedAt string `json:"created_at"`
+	Databases []int  `json:"databases"`
+}
+
+type DatabaseUserResponse struct {
+	User DatabaseUser `json:"user"`
+}
+
+type DatabaseUsersResponse struct {
+	Users []DatabaseUser `json:"users"`
+}
+
+type CreateDatabaseUserRequest struct {
+	Name      string `json:"name"`
+	Password  string `json:"password"`
+	Databases []int  `json:"databases"`
+}
+
+type UpdateDatabaseUserRequest struct {
+	Databases []int `json:"databases"`
+}
+
+type FirewallRule struct {
+	Id        int          `json:"id"`
+	Name      string       `json:"name"`
+	Port      FirewallPort `json:"port"`
+	Type      string       `json:"type"`
+	IpAddress string       `json:"ip_address"`
+	Status    string       `json:"status"`
+	CreatedAt string       `json:"created_at"`
+}
+
+type FirewallRuleResponse struct {
+	Rule FirewallRule `json:"rule"`
+}
+
+type FirewallRulesResponse struct {
+	Rules []FirewallRule `json:"rules"`
+}
+
+type CreateFirewallRuleRequest struct {
+	Name      string `json:"name"`
+	Port      string `json:"port"`
+	IpAddress string `json:"ip_address,omitempty"`
+	Type      string `json:"type"`
+}
+
+type Worker struct {
+	Id         int          `json:"id"`
+	Connection string       `json:"connection"`
+	Command    string       `json:"command"`
+	Queue      string       `json:"queue"`
+	Timeout    int          `json:"timeout"`
+	Sleep      int          `json:"sleep"`
+	Delay      int          `json:"delay"`
+	Tries      int          `json:"tries"`
+	Processes  int          `json:"processes"`
+	Daemon     FlexibleBool `json:"daemon"`
+	Force      FlexibleBool `json:"force"`
+	PhpVersion string       `json:"php_version"`
+	Status     string       `json:"status"`
+	CreatedAt  string       `json:"created_at"`
+}
+
+type WorkerResponse struct {
+	Worker Worker `json:"worker"`
+}
+
+type CreateWorkerRequest struct {
+	Connection string `json:"connection"`
+	Queue      string `json:"queue,omitempty"`
+	Timeout    int    `json:"timeout"`
+	Sleep      int    `json:"sleep"`
+	Delay      int    `json:"delay"`
+	Tries      int    `json:"tries,omitempty"`
+	Processes  int    `json:"processes"`
+	Daemon     bool   `json:"daemon"`
+	Force      bool   `json:"force"`
+	PhpVersion string `json:"php_version,omitempty"`
+}
+
+type DeploymentScriptUpdateRequest struct {
+	Content    string `json:"content"`
+	AutoSource bool   `json:"auto_source"`
+}
+
+type EnvironmentUpdateRequest struct {
+	Content string `json:"content"`
+}
+
+type Deployment struct {
+	Id            int    `json:"id"`
+	ServerId      int    `json:"server_id"`
+	SiteId        int    `json:"site_id"`
+	Type          int    `json:"type"`
+	CommitHash    string `json:"commit_hash"`
+	CommitAuthor  string `json:"commit_author"`
+	CommitMessage string `json:"commit_message"`
+	StartedAt     string `json:"started_at"`
+	EndedAt       string `json:"ended_at"`
+	Status        string `json:"status"`
+}
+
+type DeploymentsResponse struct {
+	Deployments []Deployment `json:"deployments"`
+}
+
+type DeploymentOutputResponse struct {
+	Output string `json:"output"`
+}
+
+type NginxConfigUpdateRequest struct {
+	Content string `json:"content"`
+}
+
+type NginxTemplate struct {
+	Id       int    `json:"id"`
+	ServerId int    `json:"server_id"`
+	Name     string `json:"name"`
+	Content  string `json:"content"`
+}
+
+type NginxTemplateResponse struct {
+	Template NginxTemplate `json:"template"`
+}
+
+type NginxTemplatesResponse struct {
+	Templates []NginxTemplate `json:"templates"`
+}
+
+type NginxTemplateRequest struct {
+	Name    string `json:"name"`
+	Content string `json:"content"`
+}
+
+type Webhook struct {
+	Id        int    `json:"id"`
+	Url       string `json:"url"`
+	CreatedAt string `json:"created_at"`
+}
+
+type WebhookResponse struct {
+	Webhook Webhook `json:"webhook"`
+}
+
+type WebhooksResponse struct {
+	Webhooks []Webhook `json:"webhooks"`
+}
+
+type CreateWebhookRequest struct {
+	Url string `json:"url"`
+}
+
+type SecurityRuleCredential struct {
+	Id        int    `json:"id,omitempty"`
+	Username  string `json:"username"`
+	Password  string `json:"password,omitempty"`
+	CreatedAt string `json:"created_at,omitempty"`
+}
+
+type SecurityRule struct {
+	Id          int                      `json:"id"`
+	Name        string                   `json:"name"`
+	Path        string                   `json:"path"`
+	Credentials []SecurityRuleCredential `json:"credentials"`
+	Status      string                   `json:"status"`
+	CreatedAt   string                   `json:"created_at"`
+}
+
+type SecurityRuleResponse struct {
+	SecurityRule SecurityRule `json:"security_rule"`
+}
+
+type CreateSecurityRuleRequest struct {
+	Name        string                   `json:"name"`
+	Path        string                   `json:"path,omitempty"`
+	Credentials []SecurityRuleCredential `json:"credentials"`
+}
+
+type Monitor struct {
+	Id             int    `json:"id"`
+	Status         string `json:"status"`
+	Type           string `json:"type"`
+	Operator       string `json:"operator"`
+	Threshold      int    `json:"threshold"`
+	Minutes        int    `json:"minutes"`
+	Notify         string `json:"notify"`
+	State          string `json:"state"`
+	StateChangedAt string `json:"state_changed_at"`
+}
+
+type MonitorResponse struct {
+	Monitor Monitor `json:"monitor"`
+}
+
+type MonitorsResponse struct {
+	Monitors []Monitor `json:"monitors"`
+}
+
+type CreateMonitorRequest struct {
+	Type      string `json:"type"`
+	Operator  string `json:"operator"`
+	Threshold int    `json:"threshold"`
+	Minutes   int    `json:"minutes"`
+	Notify    string `json:"notify"`
+}
+
+type Recipe struct {
+	Id        int    `json:"id"`
+	Key       string `json:"key"`
+	Name      string `json:"name"`
+	User      string `json:"user"`
+	Script    string `json:"script"`
+	CreatedAt string `json:"created_at"`
+}
+
+type RecipeResponse struct {
+	Recipe Recipe `json:"recipe"`
+}
+
+type RecipeRequest struct {
+	Name   string `json:"name"`
+	User   string `json:"user"`
+	Script string `json:"script"`
+}
+
+type RunRecipeRequest struct {
+	Servers []int `json:"servers"`
+}
+
+type BackupCredentials struct {
+	Endpoint  string `json:"endpoint,omitempty"`
+	Region    string `json:"region,omitempty"`
+	Bucket    string `json:"bucket"`
+	AccessKey string `json:"access_key"`
+	SecretKey string `json:"secret_key"`
+}
+
+type BackupFrequency struct {
+	Type   string `json:"type"`
+	Time   string `json:"time,omitempty"`
+	Day    *int   `json:"day,omitempty"`
+	Custom string `json:"custom,omitempty"`
+}
+
+type BackupConfigurationRequest struct {
+	Provider    string            `json:"provider"`
+	Credentials BackupCredentials `json:"credentials"`
+	Frequency   BackupFrequency   `json:"frequency"`
+	Directory   string            `json:"directory,omitempty"`
+	Email       string            `json:"email,omitempty"`
+	Retention   int               `json:"retention"`
+	Databases   []int             `json:"databases"`
+}
+
+type Backup struct {
+	Id                    int    `json:"id"`
+	BackupConfigurationId int    `json:"backup_configuration_id"`
+	Status                string `json:"status"`
+	RestoreStatus         string `json:"restore_status"`
+	ArchivePath           string `json:"archive_path"`
+	Size                  int    `json:"size"`
+	Uuid                  string `json:"uuid"`
+	Duration              int    `json:"duration"`
+	Date                  string `json:"date"`
+}
+
+type BackupConfiguration struct {
+	Id             int        `json:"id"`
+	DayOfWeek      *int       `json:"day_of_week"`
+	Time           string     `json:"time"`
+	Provider       string     `json:"provider"`
+	ProviderName   string     `json:"provider_name"`
+	LastBackupTime string     `json:"last_backup_time"`
+	Databases      []Database `json:"databases"`
+	Backups        []Backup   `json:"backups"`
+}
+
+type BackupConfigurationResponse struct {
+	Backup BackupConfiguration `json:"backup"`
+}
+
+type BackupConfigurationsResponse struct {
+	Backups []BackupConfiguration `json:"backups"`
+}
+
+type ServiceActionRequest struct {
+	Version string `json:"version,omitempty"`
+}
@@ -0,0 +1,98 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"log"
+	"net/http"
+	"strings"
+)
+
+func (c *Client) GetMonitor(ctx context.Context, serverId string, monitorId string) (*Monitor, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/servers/%s/monitors/%s", c.HostURL, serverId, monitorId), nil)
+	log.Printf("[INFO] [LARAVELFORGE:GetMonitor] MonitorId: %s", monitorId)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err, _ := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	monitor := MonitorResponse{}
+	err = json.Unmarshal(body, &monitor)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("[INFO] [LARAVELFORGE:GetMonitor] Monitor: %#v, Body: %#v", &monitor, body)
+
+	return &monitor.Monitor, nil
+}
+
+func (c *Client) ListMonitors(ctx context.Context, serverId string) ([]Monitor, diag.Diagnostics) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/servers/%s/monitors", c.HostURL, serverId), nil)
+	if err != nil {
+		return nil, diag.Errorf("Whoops: %s", err)
+	}
+
+	body, err, _ := c.doRequest(req)
+	log.Printf("[DEBUG] [ListMonitors] - body: %#v, Server ID: %s", string(body), serverId)
+	if err != nil {
+		return nil, diag.Errorf("Whoops: %s", err)
+	}
+
+	var monitorsResponse MonitorsResponse
+	err = json.Unmarshal(body, &monitorsResponse)
+	if err != nil {
+		return nil, diag.Errorf("Whoops: %s", err)
+	}
+
+	return monitorsResponse.Monitors, nil
+}
+
+func (c *Client) CreateMonitor(ctx context.Context, serverId string, createMonitor *CreateMonitorRequest) (*Monitor, diag.Diagnostics) {
+	log.Printf("[INFO] [LARAVELFORGE:CreateMonitor]")
+	rb, err := json.Marshal(createMonitor)
+	if err != nil {
+		return nil, diag.Errorf("Whoops: %s", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/servers/%s/monitors", c.HostURL, serverId), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, diag.Errorf("Whoops: %s", err)
+	}
+
+	body, err, _ := c.doRequest(req)
+	if err != nil {
+		return nil, diag.Errorf("Whoopsy: %s", err)
+	}
+
+	monitor := MonitorResponse{}
+	err = json.Unmarshal(body, &monitor)
+	if err != nil {
+		return nil, diag.Errorf("Whoops: %s", err)
+	}
+
+	return &monitor.Monitor, nil
+}
+
+func (c *Client) DeleteMonitor(ctx context.Context, serverId string, monitorId string) error {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/servers/%s/monitors/%s", c.HostURL, serverId, monitorId), nil)
+	if err != nil {
+		return err
+	}
+	body, err, _ := c.doRequest(req)
+	if err != nil {
+		return err
+	}
+
+	if string(body) != "" {
+		return errors.New(string(body))
+	}
+
+	return nil
+}
@@ -0,0 +1,68 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"log"
+	"net/http"
+	"strings"
+)
+
+func (c *Client) GetNginxConfig(ctx context.Context, serverId string, siteId string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/servers/%s/sites/%s/nginx", c.HostURL, serverId, siteId), nil)
+	log.Printf("[INFO] [LARAVELFORGE:GetNginxConfig] SiteId: %s", siteId)
+	if err != nil {
+		return "", err
+	}
+
+	body, err, _ := c.doRequest(req)
+	if err != nil {
+		return "", err
+	}
+
+	return decodeTextBody(body), nil
+}
+
+func (c *Client) UpdateNginxConfig(ctx context.Context, serverId string, siteId string, configUpdate NginxConfigUpdateRequest) diag.Diagnostics {
+	log.Printf("[INFO] [LARAVELFORGE:UpdateNginxConfig] SiteId: %s", siteId)
+	rb, err := json.Marshal(configUpdate)
+	if err != nil {
+		return diag.Errorf("Whoops: %s", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("%s/servers/%s/sites/%s/nginx", c.HostURL, serverId, siteId), strings.NewReader(string(rb)))
+	if err != nil {
+		return diag.Errorf("Whoops: %s", err)
+	}
+
+	err = c.doRequestEmptyBody(req)
+	if err != nil {
+		return diag.Errorf("Whoops: %s", err)
+	}
+
+	return nil
+}
+
+// GetDefaultNginxTemplate - Returns the template Forge renders the Nginx configuration of new sites from
+func (c *Client) GetDefaultNginxTemplate(ctx context.Context, serverId string) (*NginxTemplate, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/servers/%s/nginx/templates/default", c.HostURL, serverId), nil)
+	log.Printf("[INFO] [LARAVELFORGE:GetDefaultNginxTemplate] ServerId: %s", serverId)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err, _ := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	template := NginxTemplateResponse{}
+	err = json.Unmarshal(body, &template)
+	if err != nil {
+		return nil, err
+	}
+
+	return &template.Template, nil
+}
@@ -0,0 +1,122 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"log"
+	"net/http"
+	"strings"
+)
+
+func (c *Client) GetNginxTemplate(ctx context.Context, serverId string, templateId string) (*NginxTemplate, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/servers/%s/nginx/templates/%s", c.HostURL, serverId, templateId), nil)
+	log.Printf("[INFO] [LARAVELFORGE:GetNginxTemplate] TemplateId: %s", templateId)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err, _ := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	template := NginxTemplateResponse{}
+	err = json.Unmarshal(body, &template)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("[INFO] [LARAVELFORGE:GetNginxTemplate] Template: %#v, Body: %#v", &template, body)
+
+	return &template.Template, nil
+}
+
+func (c *Client) ListNginxTemplates(ctx context.Context, serverId string) ([]NginxTemplate, diag.Diagnostics) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/servers/%s/nginx/templates", c.HostURL, serverId), nil)
+	if err != nil {
+		return nil, diag.Errorf("Whoops: %s", err)
+	}
+
+	body, err, _ := c.doRequest(req)
+	log.Printf("[DEBUG] [ListNginxTemplates] - body: %#v, Server ID: %s", string(body), serverId)
+	if err != nil {
+		return nil, diag.Errorf("Whoops: %s", err)
+	}
+
+	var templatesResponse NginxTemplatesResponse
+	err = json.Unmarshal(body, &templatesResponse)
+	if err != nil {
+		return nil, diag.Errorf("Whoops: %s", err)
+	}
+
+	return templatesResponse.Templates, nil
+}
+
+func (c *Client) CreateNginxTemplate(ctx context.Context, serverId string, createTemplate *NginxTemplateRequest) (*NginxTemplate, diag.Diagnostics) {
+	log.Printf("[INFO] [LARAVELFORGE:CreateNginxTemplate]")
+	rb, err := json.Marshal(createTemplate)
+	if err != nil {
+		return nil, diag.Errorf("Whoops: %s", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/servers/%s/nginx/templates", c.HostURL, serverId), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, diag.Errorf("Whoops: %s", err)
+	}
+
+	body, err, _ := c.doRequest(req)
+	if err != nil {
+		return nil, diag.Errorf("Whoopsy: %s", err)
+	}
+
+	template := NginxTemplateResponse{}
+	err = json.Unmarshal(body, &template)
+	if err != nil {
+		return nil, diag.Errorf("Whoops: %s", err)
+	}
+
+	return &template.Template, nil
+}
+
+func (c *Client) UpdateNginxTemplate(ctx context.Context, serverId string, templateId string, templateUpdates NginxTemplateRequest) (*NginxTemplate, diag.Diagnostics) {
+	rb, err := json.Marshal(templateUpdates)
+	if err != nil {
+		return nil, diag.Errorf("Whoops: %s", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("%s/servers/%s/nginx/templates/%s", c.HostURL, serverId, templateId), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, diag.Errorf("Whoops: %s", err)
+	}
+
+	body, err, _ := c.doRequest(req)
+	if err != nil {
+		return nil, diag.Errorf("Whoops: %s", err)
+	}
+
+	template := NginxTemplateResponse{}
+	err = json.Unmarshal(body, &template)
+	if err != nil {
+		return nil, diag.Errorf("Whoops: %s", err)
+	}
+
+	return &template.Template, nil
+}
+
+func (c *Client) DeleteNginxTemplate(ctx context.Context, serverId string, templateId string) error {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/servers/%s/nginx/templates/%s", c.HostURL, serverId, templateId), nil)
+	if err != nil {
+		return err
+	}
+	body, err, _ := c.doRequest(req)
+	if err != nil {
+		return err
+	}
+
+	if string(body) != "" {
+		return errors.New(string(body))
+	}
+
+	return nil
+}
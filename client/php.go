@@ -0,0 +1,89 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"log"
+	"net/http"
+	"strings"
+)
+
+func (c *Client) ListPhpVersions(ctx context.Context, serverId string) ([]PhpVersion, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/servers/%s/php", c.HostURL, serverId), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err, _ := c.doRequest(req)
+	log.Printf("[DEBUG] [ListPhpVersions] - body: %#v, Server ID: %s", string(body), serverId)
+	if err != nil {
+		return nil, err
+	}
+
+	var phpVersions []PhpVersion
+	err = json.Unmarshal(body, &phpVersions)
+	if err != nil {
+		return nil, err
+	}
+
+	return phpVersions, nil
+}
+
+// GetPhpVersion - Returns an installed PHP version, or nil when the version is not installed
+func (c *Client) GetPhpVersion(ctx context.Context, serverId string, version string) (*PhpVersion, error) {
+	log.Printf("[INFO] [LARAVELFORGE:GetPhpVersion] Version: %s", version)
+	phpVersions, err := c.ListPhpVersions(ctx, serverId)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, phpVersion := range phpVersions {
+		if phpVersion.Version == version {
+			return &phpVersion, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (c *Client) InstallPhpVersion(ctx context.Context, serverId string, version string) diag.Diagnostics {
+	return c.phpVersionAction(ctx, http.MethodPost, fmt.Sprintf("%s/servers/%s/php", c.HostURL, serverId), version)
+}
+
+func (c *Client) UpdatePhpVersion(ctx context.Context, serverId string, version string) diag.Diagnostics {
+	return c.phpVersionAction(ctx, http.MethodPost, fmt.Sprintf("%s/servers/%s/php/update", c.HostURL, serverId), version)
+}
+
+func (c *Client) DeletePhpVersion(ctx context.Context, serverId string, version string) diag.Diagnostics {
+	return c.phpVersionAction(ctx, http.MethodDelete, fmt.Sprintf("%s/servers/%s/php", c.HostURL, serverId), version)
+}
+
+func (c *Client) SetPhpCliVersion(ctx context.Context, serverId string, version string) diag.Diagnostics {
+	return c.phpVersionAction(ctx, http.MethodPut, fmt.Sprintf("%s/servers/%s/php/cli", c.HostURL, serverId), version)
+}
+
+func (c *Client) SetPhpSiteVersion(ctx context.Context, serverId string, version string) diag.Diagnostics {
+	return c.phpVersionAction(ctx, http.MethodPut, fmt.Sprintf("%s/servers/%s/php/site", c.HostURL, serverId), version)
+}
+
+func (c *Client) phpVersionAction(ctx context.Context, method string, url string, version string) diag.Diagnostics {
+	log.Printf("[INFO] [LARAVELFORGE:phpVersionAction] %s %s Version: %s", method, url, version)
+	rb, err := json.Marshal(PhpVersionRequest{Version: version})
+	if err != nil {
+		return diag.Errorf("Whoops: %s", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, url, strings.NewReader(string(rb)))
+	if err != nil {
+		return diag.Errorf("Whoops: %s", err)
+	}
+
+	err = c.doRequestEmptyBody(req)
+	if err != nil {
+		return diag.Errorf("Whoops: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,73 @@
+package client
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// DefaultRequestsPerMinute - Default client side limit, a bit below the 60 requests per minute Forge allows
+const DefaultRequestsPerMinute int = 50
+
+// rateLimiter - Token bucket shared by all requests of a client. The bucket holds up to a tenth
+// of the per minute limit so short bursts go out immediately, and is refilled at a steady rate.
+type rateLimiter struct {
+	mu       sync.Mutex
+	tokens   float64
+	capacity float64
+	rate     float64
+	last     time.Time
+}
+
+func newRateLimiter(requestsPerMinute int) *rateLimiter {
+	capacity := float64(requestsPerMinute / 10)
+	if capacity < 1 {
+		capacity = 1
+	}
+
+	return &rateLimiter{
+		tokens:   capacity,
+		capacity: capacity,
+		rate:     float64(requestsPerMinute) / 60,
+		last:     time.Now(),
+	}
+}
+
+// SetRateLimit - Limits the number of requests sent per minute, 0 disables the limit
+func (c *Client) SetRateLimit(requestsPerMinute int) {
+	if requestsPerMinute <= 0 {
+		c.limiter = nil
+		return
+	}
+
+	c.limiter = newRateLimiter(requestsPerMinute)
+}
+
+// reserve - Takes a token from the bucket and returns how long to wait until it may be used
+func (l *rateLimiter) reserve() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	l.tokens += now.Sub(l.last).Seconds() * l.rate
+	if l.tokens > l.capacity {
+		l.tokens = l.capacity
+	}
+	l.last = now
+
+	l.tokens--
+	if l.tokens >= 0 {
+		return 0
+	}
+
+	return time.Duration(-l.tokens / l.rate * float64(time.Second))
+}
+
+// wait - Blocks until the next request may be sent, or until the context is done
+func (l *rateLimiter) wait(ctx context.Context) error {
+	if l == nil {
+		return nil
+	}
+
+	return SleepContext(ctx, l.reserve())
+}
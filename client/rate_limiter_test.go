@@ -0,0 +1,101 @@
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+// drain takes all tokens from the bucket and fails when any of them has to be waited for.
+func drain(t *testing.T, l *rateLimiter) {
+	for i := 0; i < int(l.capacity); i++ {
+		if wait := l.reserve(); wait != 0 {
+			t.Fatalf("expected request %d of the burst to go out immediately, got a wait of %s", i+1, wait)
+		}
+	}
+}
+
+func TestRateLimiterAllowsBursts(t *testing.T) {
+	l := newRateLimiter(60)
+	if l.capacity != 6 {
+		t.Fatalf("expected a burst of 6 requests, got %v", l.capacity)
+	}
+
+	drain(t, l)
+
+	if wait := l.reserve(); wait < 900*time.Millisecond || wait > time.Second {
+		t.Errorf("expected to wait about a second for the next token, got %s", wait)
+	}
+
+	if wait := l.reserve(); wait < 1900*time.Millisecond || wait > 2*time.Second {
+		t.Errorf("expected waits to add up while the bucket is empty, got %s", wait)
+	}
+}
+
+func TestRateLimiterRefills(t *testing.T) {
+	l := newRateLimiter(60)
+	drain(t, l)
+
+	l.last = l.last.Add(-2 * time.Second)
+
+	for i := 0; i < 2; i++ {
+		if wait := l.reserve(); wait != 0 {
+			t.Fatalf("expected refilled token %d to be available, got a wait of %s", i+1, wait)
+		}
+	}
+
+	if wait := l.reserve(); wait == 0 {
+		t.Error("expected to wait once the refilled tokens are used")
+	}
+}
+
+func TestRateLimiterCapsRefill(t *testing.T) {
+	l := newRateLimiter(60)
+	l.last = l.last.Add(-time.Hour)
+
+	drain(t, l)
+
+	if wait := l.reserve(); wait == 0 {
+		t.Error("expected the bucket to hold no more than its capacity")
+	}
+}
+
+func TestRateLimiterMinimumBurst(t *testing.T) {
+	l := newRateLimiter(5)
+	drain(t, l)
+
+	if wait := l.reserve(); wait < 11*time.Second || wait > 12*time.Second {
+		t.Errorf("expected to wait about 12 seconds at 5 requests per minute, got %s", wait)
+	}
+}
+
+func TestRateLimiterWaitIsCancellable(t *testing.T) {
+	l := newRateLimiter(1)
+	drain(t, l)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if err := l.wait(ctx); err != context.Canceled {
+		t.Errorf("expected the wait to end with the context, got %v", err)
+	}
+}
+
+func TestSetRateLimit(t *testing.T) {
+	token := "token"
+	c, _ := NewClient(nil, &token)
+
+	c.SetRateLimit(120)
+	if c.limiter == nil || c.limiter.rate != 2 {
+		t.Fatalf("expected a limit of 2 requests per second, got %#v", c.limiter)
+	}
+
+	c.SetRateLimit(0)
+	if c.limiter != nil {
+		t.Fatalf("expected no limiter, got %#v", c.limiter)
+	}
+
+	if err := c.limiter.wait(context.Background()); err != nil {
+		t.Errorf("expected a missing limiter not to wait, got %v", err)
+	}
+}
@@ -0,0 +1,121 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"log"
+	"net/http"
+	"strings"
+)
+
+func (c *Client) GetRecipe(ctx context.Context, recipeId string) (*Recipe, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/recipes/%s", c.HostURL, recipeId), nil)
+	log.Printf("[INFO] [LARAVELFORGE:GetRecipe] RecipeId: %s", recipeId)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err, _ := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	recipe := RecipeResponse{}
+	err = json.Unmarshal(body, &recipe)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("[INFO] [LARAVELFORGE:GetRecipe] Recipe: %#v, Body: %#v", &recipe, body)
+
+	return &recipe.Recipe, nil
+}
+
+func (c *Client) CreateRecipe(ctx context.Context, createRecipe *RecipeRequest) (*Recipe, diag.Diagnostics) {
+	log.Printf("[INFO] [LARAVELFORGE:CreateRecipe]")
+	rb, err := json.Marshal(createRecipe)
+	if err != nil {
+		return nil, diag.Errorf("Whoops: %s", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/recipes", c.HostURL), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, diag.Errorf("Whoops: %s", err)
+	}
+
+	body, err, _ := c.doRequest(req)
+	if err != nil {
+		return nil, diag.Errorf("Whoopsy: %s", err)
+	}
+
+	recipe := RecipeResponse{}
+	err = json.Unmarshal(body, &recipe)
+	if err != nil {
+		return nil, diag.Errorf("Whoops: %s", err)
+	}
+
+	return &recipe.Recipe, nil
+}
+
+func (c *Client) UpdateRecipe(ctx context.Context, recipeId string, recipeUpdates RecipeRequest) (*Recipe, diag.Diagnostics) {
+	rb, err := json.Marshal(recipeUpdates)
+	if err != nil {
+		return nil, diag.Errorf("Whoops: %s", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("%s/recipes/%s", c.HostURL, recipeId), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, diag.Errorf("Whoops: %s", err)
+	}
+
+	body, err, _ := c.doRequest(req)
+	if err != nil {
+		return nil, diag.Errorf("Whoops: %s", err)
+	}
+
+	recipe := RecipeResponse{}
+	err = json.Unmarshal(body, &recipe)
+	if err != nil {
+		return nil, diag.Errorf("Whoops: %s", err)
+	}
+
+	return &recipe.Recipe, nil
+}
+
+func (c *Client) RunRecipe(ctx context.Context, recipeId string, runRecipe RunRecipeRequest) diag.Diagnostics {
+	log.Printf("[INFO] [LARAVELFORGE:RunRecipe] RecipeId: %s", recipeId)
+	rb, err := json.Marshal(runRecipe)
+	if err != nil {
+		return diag.Errorf("Whoops: %s", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/recipes/%s/run", c.HostURL, recipeId), strings.NewReader(string(rb)))
+	if err != nil {
+		return diag.Errorf("Whoops: %s", err)
+	}
+
+	err = c.doRequestEmptyBody(req)
+	if err != nil {
+		return diag.Errorf("Whoopsy: %s", err)
+	}
+
+	return nil
+}
+
+func (c *Client) DeleteRecipe(ctx context.Context, recipeId string) error {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/recipes/%s", c.HostURL, recipeId), nil)
+	if err != nil {
+		return err
+	}
+	body, err, _ := c.doRequest(req)
+	if err != nil {
+		return err
+	}
+
+	if string(body) != "" {
+		return errors.New(string(body))
+	}
+
+	return nil
+}
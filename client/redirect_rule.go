@@ -1,6 +1,7 @@
 package client
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -10,36 +11,36 @@ import (
 	"strings"
 )
 
-func (c *Client) GetRedirectRule(serverId string, siteId string, redirectRuleId string) (*RedirectRule, diag.Diagnostics) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/servers/%s/sites/%s/redirect-rules/%s", c.HostURL, serverId, siteId, redirectRuleId), nil)
+func (c *Client) GetRedirectRule(ctx context.Context, serverId string, siteId string, redirectRuleId string) (*RedirectRule, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/servers/%s/sites/%s/redirect-rules/%s", c.HostURL, serverId, siteId, redirectRuleId), nil)
 	log.Printf("[INFO] [LARAVELFORGE:GetRedirectRule] RuleId: %s", redirectRuleId)
 	if err != nil {
-		return nil, diag.Errorf("Whoops: %s", err)
+		return nil, err
 	}
 
 	body, err, _ := c.doRequest(req)
 	if err != nil {
-		return nil, diag.Errorf("Whoops: %s", err)
+		return nil, err
 	}
 
 	redirectRule := RedirectRuleResponse{}
 	err = json.Unmarshal(body, &redirectRule)
 	if err != nil {
-		return nil, diag.Errorf("Whoops: %s", err)
+		return nil, err
 	}
 	log.Printf("[INFO] [LARAVELFORGE:GetRedirectRule] Rule: %#v, Body: %#v", &redirectRule, body)
 
 	return &redirectRule.RedirectRule, nil
 }
 
-func (c *Client) CreateRedirectRule(serverId string, siteId string, createRuleRequest *CreateRedirectRuleRequest) (*RedirectRule, diag.Diagnostics) {
+func (c *Client) CreateRedirectRule(ctx context.Context, serverId string, siteId string, createRuleRequest *CreateRedirectRuleRequest) (*RedirectRule, diag.Diagnostics) {
 	log.Printf("[INFO] [LARAVELFORGE:CreateRedirectRule]")
 	rb, err := json.Marshal(createRuleRequest)
 	if err != nil {
 		return nil, diag.Errorf("Whoops: %s", err)
 	}
 
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/servers/%s/sites/%s/redirect-rules", c.HostURL, serverId, siteId), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/servers/%s/sites/%s/redirect-rules", c.HostURL, serverId, siteId), strings.NewReader(string(rb)))
 	if err != nil {
 		return nil, diag.Errorf("Whoops: %s", err)
 	}
@@ -58,8 +59,8 @@ func (c *Client) CreateRedirectRule(serverId string, siteId string, createRuleRe
 	return &redirectRule.RedirectRule, nil
 }
 
-func (c *Client) DeleteRedirectRule(serverId string, siteId string, redirectRuleId string) error {
-	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/servers/%s/sites/%s/redirect-rules/%s", c.HostURL, serverId, siteId, redirectRuleId), nil)
+func (c *Client) DeleteRedirectRule(ctx context.Context, serverId string, siteId string, redirectRuleId string) error {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/servers/%s/sites/%s/redirect-rules/%s", c.HostURL, serverId, siteId, redirectRuleId), nil)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,138 @@
+package client
+
+import (
+	"io"
+	"log"
+	"math"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// DefaultMaxRetries - Default number of times a failed request is retried
+const DefaultMaxRetries int = 5
+
+// DefaultRetryMaxWait - Default upper limit of the wait between two attempts
+const DefaultRetryMaxWait time.Duration = 60 * time.Second
+
+// retryBaseWait - Wait before the first retry, doubled on every following attempt
+const retryBaseWait time.Duration = time.Second
+
+// SetRetryPolicy - Sets how often and how long failed requests are retried
+func (c *Client) SetRetryPolicy(maxRetries int, maxWait time.Duration) {
+	c.MaxRetries = maxRetries
+	c.RetryMaxWait = maxWait
+}
+
+// send - Sends a request, retrying it when Forge is rate limiting or temporarily unavailable
+func (c *Client) send(req *http.Request) (*http.Response, []byte, error) {
+	for attempt := 0; ; attempt++ {
+		res, body, err := c.sendOnce(req)
+
+		retry := false
+		switch {
+		case err != nil:
+			// The request may have reached Forge, so only retry when doing so is safe.
+			retry = isIdempotent(req.Method)
+		case res.StatusCode == http.StatusTooManyRequests:
+			// Rate limited requests are rejected before they are processed.
+			retry = true
+		case res.StatusCode >= http.StatusInternalServerError:
+			retry = isIdempotent(req.Method)
+		}
+
+		if !retry || attempt >= c.MaxRetries {
+			return res, body, err
+		}
+
+		wait := c.retryWait(res, attempt)
+		log.Printf("[DEBUG] [LARAVELFORGE:send] Retrying %s %s in %s (attempt %d of %d), Error: %v", req.Method, req.URL, wait, attempt+1, c.MaxRetries, err)
+		if err := SleepContext(req.Context(), wait); err != nil {
+			return nil, nil, err
+		}
+
+		if req.GetBody != nil {
+			req.Body, err = req.GetBody()
+			if err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+}
+
+func (c *Client) sendOnce(req *http.Request) (*http.Response, []byte, error) {
+	if err := c.limiter.wait(req.Context()); err != nil {
+		return nil, nil, err
+	}
+
+	res, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return res, nil, err
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+
+	return res, body, err
+}
+
+// retryWait - Returns how long to wait before the next attempt. The wait requested by
+// Forge is used when available, otherwise the wait grows exponentially with some jitter.
+func (c *Client) retryWait(res *http.Response, attempt int) time.Duration {
+	wait, ok := rateLimitWait(res)
+	if !ok {
+		backoff := float64(retryBaseWait) * math.Pow(2, float64(attempt))
+		if backoff > float64(c.RetryMaxWait) {
+			backoff = float64(c.RetryMaxWait)
+		}
+		wait = time.Duration(backoff/2 + rand.Float64()*backoff/2)
+	}
+
+	if wait > c.RetryMaxWait {
+		wait = c.RetryMaxWait
+	}
+
+	return wait
+}
+
+// rateLimitWait - Reads the wait from the Retry-After or X-RateLimit-Reset headers
+func rateLimitWait(res *http.Response) (time.Duration, bool) {
+	if res == nil {
+		return 0, false
+	}
+
+	if retryAfter := res.Header.Get("Retry-After"); retryAfter != "" {
+		if seconds, err := strconv.Atoi(retryAfter); err == nil && seconds >= 0 {
+			return time.Duration(seconds) * time.Second, true
+		}
+		if date, err := http.ParseTime(retryAfter); err == nil {
+			return nonNegative(time.Until(date)), true
+		}
+	}
+
+	if res.Header.Get("X-RateLimit-Remaining") == "0" {
+		if reset, err := strconv.ParseInt(res.Header.Get("X-RateLimit-Reset"), 10, 64); err == nil {
+			return nonNegative(time.Until(time.Unix(reset, 0))), true
+		}
+	}
+
+	return 0, false
+}
+
+func nonNegative(d time.Duration) time.Duration {
+	if d < 0 {
+		return 0
+	}
+
+	return d
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,253 @@
+package client
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// testServer records the bodies of the requests it receives and responds
+// with the given statuses in turn, repeating the last one.
+type testServer struct {
+	mu       sync.Mutex
+	bodies   []string
+	statuses []int
+	header   http.Header
+}
+
+func (s *testServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	body, _ := io.ReadAll(r.Body)
+	s.bodies = append(s.bodies, string(body))
+
+	status := s.statuses[len(s.statuses)-1]
+	if len(s.bodies) <= len(s.statuses) {
+		status = s.statuses[len(s.bodies)-1]
+	}
+
+	for key, values := range s.header {
+		w.Header()[key] = values
+	}
+	w.WriteHeader(status)
+	w.Write([]byte(http.StatusText(status)))
+}
+
+func newTestClient(t *testing.T, handler http.Handler) *Client {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	token := "token"
+	c, err := NewClient(&server.URL, &token)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.SetRetryPolicy(3, 10*time.Millisecond)
+	c.SetRateLimit(0)
+
+	return c
+}
+
+func TestSendRetriesRateLimitedRequests(t *testing.T) {
+	server := &testServer{
+		statuses: []int{http.StatusTooManyRequests, http.StatusTooManyRequests, http.StatusOK},
+		header:   http.Header{"Retry-After": []string{"0"}},
+	}
+	c := newTestClient(t, server)
+
+	req, _ := http.NewRequest(http.MethodPost, c.HostURL+"/servers", strings.NewReader(`{"name":"web"}`))
+	res, body, err := c.send(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if res.StatusCode != http.StatusOK || string(body) != "OK" {
+		t.Errorf("expected the last response, got %d %q", res.StatusCode, body)
+	}
+
+	if len(server.bodies) != 3 {
+		t.Fatalf("expected 3 attempts, got %d", len(server.bodies))
+	}
+
+	for i, body := range server.bodies {
+		if body != `{"name":"web"}` {
+			t.Errorf("expected the body to be sent again on attempt %d, got %q", i+1, body)
+		}
+	}
+}
+
+func TestSendRetriesServerErrorsOfIdempotentRequests(t *testing.T) {
+	for _, method := range []string{http.MethodGet, http.MethodPut, http.MethodDelete} {
+		server := &testServer{statuses: []int{http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusOK}}
+		c := newTestClient(t, server)
+
+		req, _ := http.NewRequest(method, c.HostURL+"/servers/1", nil)
+		res, _, err := c.send(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if res.StatusCode != http.StatusOK || len(server.bodies) != 3 {
+			t.Errorf("%s: expected 3 attempts ending in 200, got %d attempts ending in %d", method, len(server.bodies), res.StatusCode)
+		}
+	}
+}
+
+func TestSendDoesNotRetryServerErrorsOfPostRequests(t *testing.T) {
+	server := &testServer{statuses: []int{http.StatusInternalServerError, http.StatusOK}}
+	c := newTestClient(t, server)
+
+	req, _ := http.NewRequest(http.MethodPost, c.HostURL+"/servers", strings.NewReader("{}"))
+	res, _, err := c.send(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if res.StatusCode != http.StatusInternalServerError || len(server.bodies) != 1 {
+		t.Errorf("expected a single attempt ending in 500, got %d attempts ending in %d", len(server.bodies), res.StatusCode)
+	}
+}
+
+func TestSendDoesNotRetryClientErrors(t *testing.T) {
+	server := &testServer{statuses: []int{http.StatusNotFound, http.StatusOK}}
+	c := newTestClient(t, server)
+
+	req, _ := http.NewRequest(http.MethodGet, c.HostURL+"/servers/1", nil)
+	res, _, err := c.send(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if res.StatusCode != http.StatusNotFound || len(server.bodies) != 1 {
+		t.Errorf("expected a single attempt ending in 404, got %d attempts ending in %d", len(server.bodies), res.StatusCode)
+	}
+}
+
+func TestSendGivesUpAfterMaxRetries(t *testing.T) {
+	server := &testServer{statuses: []int{http.StatusServiceUnavailable}}
+	c := newTestClient(t, server)
+
+	req, _ := http.NewRequest(http.MethodGet, c.HostURL+"/servers", nil)
+	res, _, err := c.send(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if res.StatusCode != http.StatusServiceUnavailable || len(server.bodies) != c.MaxRetries+1 {
+		t.Errorf("expected %d attempts ending in 503, got %d attempts ending in %d", c.MaxRetries+1, len(server.bodies), res.StatusCode)
+	}
+}
+
+func TestDoRequestWithoutResponse(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	url := server.URL
+	server.Close()
+
+	token := "token"
+	c, _ := NewClient(&url, &token)
+	c.SetRetryPolicy(2, 10*time.Millisecond)
+
+	req, _ := http.NewRequest(http.MethodGet, c.HostURL+"/servers", nil)
+	_, err, res := c.doRequest(req)
+	if err == nil {
+		t.Fatal("expected an error when the API can't be reached")
+	}
+
+	if res != nil {
+		t.Errorf("expected no response, got %#v", res)
+	}
+}
+
+func TestRateLimitWait(t *testing.T) {
+	cases := []struct {
+		name   string
+		header http.Header
+		min    time.Duration
+		max    time.Duration
+		ok     bool
+	}{
+		{
+			name:   "no headers",
+			header: http.Header{},
+		},
+		{
+			name:   "retry after seconds",
+			header: http.Header{"Retry-After": []string{"7"}},
+			min:    7 * time.Second,
+			max:    7 * time.Second,
+			ok:     true,
+		},
+		{
+			name:   "retry after date",
+			header: http.Header{"Retry-After": []string{time.Now().Add(30 * time.Second).UTC().Format(http.TimeFormat)}},
+			min:    28 * time.Second,
+			max:    30 * time.Second,
+			ok:     true,
+		},
+		{
+			name:   "retry after date in the past",
+			header: http.Header{"Retry-After": []string{time.Now().Add(-time.Minute).UTC().Format(http.TimeFormat)}},
+			ok:     true,
+		},
+		{
+			name:   "invalid retry after",
+			header: http.Header{"Retry-After": []string{"soon"}},
+		},
+		{
+			name: "rate limit reset",
+			header: http.Header{
+				"X-Ratelimit-Remaining": []string{"0"},
+				"X-Ratelimit-Reset":     []string{unixString(time.Now().Add(20 * time.Second))},
+			},
+			min: 18 * time.Second,
+			max: 20 * time.Second,
+			ok:  true,
+		},
+		{
+			name: "requests remaining",
+			header: http.Header{
+				"X-Ratelimit-Remaining": []string{"12"},
+				"X-Ratelimit-Reset":     []string{unixString(time.Now().Add(20 * time.Second))},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			wait, ok := rateLimitWait(&http.Response{Header: c.header})
+			if ok != c.ok || wait < c.min || wait > c.max {
+				t.Errorf("expected a wait between %s and %s (%t), got %s (%t)", c.min, c.max, c.ok, wait, ok)
+			}
+		})
+	}
+
+	if _, ok := rateLimitWait(nil); ok {
+		t.Error("expected no wait without a response")
+	}
+}
+
+func TestRetryWait(t *testing.T) {
+	c := &Client{RetryMaxWait: 8 * time.Second}
+
+	limited := &http.Response{Header: http.Header{"Retry-After": []string{"120"}}}
+	if wait := c.retryWait(limited, 0); wait != c.RetryMaxWait {
+		t.Errorf("expected the requested wait to be capped at %s, got %s", c.RetryMaxWait, wait)
+	}
+
+	for attempt, max := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second, 8 * time.Second} {
+		wait := c.retryWait(nil, attempt)
+		if wait < max/2 || wait > max {
+			t.Errorf("expected the wait of attempt %d to be between %s and %s, got %s", attempt, max/2, max, wait)
+		}
+	}
+}
+
+func unixString(t time.Time) string {
+	return strconv.FormatInt(t.Unix(), 10)
+}
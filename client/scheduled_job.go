@@ -1,6 +1,7 @@
 package client
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -10,36 +11,36 @@ import (
 	"strings"
 )
 
-func (c *Client) GetScheduledJob(serverId string, jobId string) (*ScheduledJob, diag.Diagnostics) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/servers/%s/jobs/%s", c.HostURL, serverId, jobId), nil)
+func (c *Client) GetScheduledJob(ctx context.Context, serverId string, jobId string) (*ScheduledJob, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/servers/%s/jobs/%s", c.HostURL, serverId, jobId), nil)
 	log.Printf("[INFO] [LARAVELFORGE:GetScheduledJob] SiteId: %s", jobId)
 	if err != nil {
-		return nil, diag.Errorf("Whoops: %s", err)
+		return nil, err
 	}
 
 	body, err, _ := c.doRequest(req)
 	if err != nil {
-		return nil, diag.Errorf("Whoops: %s", err)
+		return nil, err
 	}
 
 	job := ScheduledJobResponse{}
 	err = json.Unmarshal(body, &job)
 	if err != nil {
-		return nil, diag.Errorf("Whoops: %s", err)
+		return nil, err
 	}
 	log.Printf("[INFO] [LARAVELFORGE:GetScheduledJob] Job: %#v, Body: %#v", &job, body)
 
 	return &job.Job, nil
 }
 
-func (c *Client) CreateScheduledJob(serverId string, createJob *CreateScheduledJob) (*ScheduledJob, diag.Diagnostics) {
+func (c *Client) CreateScheduledJob(ctx context.Context, serverId string, createJob *CreateScheduledJob) (*ScheduledJob, diag.Diagnostics) {
 	log.Printf("[INFO] [LARAVELFORGE:CreateScheduledJob]")
 	rb, err := json.Marshal(createJob)
 	if err != nil {
 		return nil, diag.Errorf("Whoops: %s", err)
 	}
 
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/servers/%s/jobs", c.HostURL, serverId), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/servers/%s/jobs", c.HostURL, serverId), strings.NewReader(string(rb)))
 	if err != nil {
 		return nil, diag.Errorf("Whoops: %s", err)
 	}
@@ -58,8 +59,8 @@ func (c *Client) CreateScheduledJob(serverId string, createJob *CreateScheduledJ
 	return &job.Job, nil
 }
 
-func (c *Client) DeleteScheduledJob(serverId string, jobId string) error {
-	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/servers/%s/jobs/%s", c.HostURL, serverId, jobId), nil)
+func (c *Client) DeleteScheduledJob(ctx context.Context, serverId string, jobId string) error {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/servers/%s/jobs/%s", c.HostURL, serverId, jobId), nil)
 	if err != nil {
 		return err
 	}
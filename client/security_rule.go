@@ -0,0 +1,77 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"log"
+	"net/http"
+	"strings"
+)
+
+func (c *Client) GetSecurityRule(ctx context.Context, serverId string, siteId string, ruleId string) (*SecurityRule, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/servers/%s/sites/%s/security-rules/%s", c.HostURL, serverId, siteId, ruleId), nil)
+	log.Printf("[INFO] [LARAVELFORGE:GetSecurityRule] RuleId: %s", ruleId)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err, _ := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	rule := SecurityRuleResponse{}
+	err = json.Unmarshal(body, &rule)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("[INFO] [LARAVELFORGE:GetSecurityRule] Rule: %#v, Body: %#v", &rule, body)
+
+	return &rule.SecurityRule, nil
+}
+
+func (c *Client) CreateSecurityRule(ctx context.Context, serverId string, siteId string, createRule *CreateSecurityRuleRequest) (*SecurityRule, diag.Diagnostics) {
+	log.Printf("[INFO] [LARAVELFORGE:CreateSecurityRule]")
+	rb, err := json.Marshal(createRule)
+	if err != nil {
+		return nil, diag.Errorf("Whoops: %s", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/servers/%s/sites/%s/security-rules", c.HostURL, serverId, siteId), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, diag.Errorf("Whoops: %s", err)
+	}
+
+	body, err, _ := c.doRequest(req)
+	if err != nil {
+		return nil, diag.Errorf("Whoopsy: %s", err)
+	}
+
+	rule := SecurityRuleResponse{}
+	err = json.Unmarshal(body, &rule)
+	if err != nil {
+		return nil, diag.Errorf("Whoops: %s", err)
+	}
+
+	return &rule.SecurityRule, nil
+}
+
+func (c *Client) DeleteSecurityRule(ctx context.Context, serverId string, siteId string, ruleId string) error {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/servers/%s/sites/%s/security-rules/%s", c.HostURL, serverId, siteId, ruleId), nil)
+	if err != nil {
+		return err
+	}
+	body, err, _ := c.doRequest(req)
+	if err != nil {
+		return err
+	}
+
+	if string(body) != "" {
+		return errors.New(string(body))
+	}
+
+	return nil
+}
@@ -1,6 +1,7 @@
 package client
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -10,8 +11,8 @@ import (
 	"strings"
 )
 
-func (c *Client) GetServer(serverId string) (*Server, error, *http.Response) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/servers/%s", c.HostURL, serverId), nil)
+func (c *Client) GetServer(ctx context.Context, serverId string) (*Server, error, *http.Response) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/servers/%s", c.HostURL, serverId), nil)
 	log.Printf("[INFO] [LARAVELFORGE:GetServer] ServerId: %s", serverId)
 	if err != nil {
 		return nil, err, nil
@@ -19,7 +20,7 @@ func (c *Client) GetServer(serverId string) (*Server, error, *http.Response) {
 
 	body, err, res := c.doRequest(req)
 	if err != nil {
-		if res.StatusCode == 404 {
+		if res != nil && res.StatusCode == 404 {
 			log.Printf("[INFO] [LARAVELFORGE:GetServer] Response: %#v", res)
 		}
 		return nil, err, res
@@ -35,14 +36,14 @@ func (c *Client) GetServer(serverId string) (*Server, error, *http.Response) {
 	return &server.Server, nil, res
 }
 
-func (c *Client) CreateServer(createServer *ServerCreateRequest) (*ServerResponse, error, *http.Response) {
+func (c *Client) CreateServer(ctx context.Context, createServer *ServerCreateRequest) (*ServerResponse, error, *http.Response) {
 	log.Printf("[INFO] [LARAVELFORGE:CreateServer]")
 	rb, err := json.Marshal(createServer)
 	if err != nil {
 		return nil, err, nil
 	}
 
-	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/servers", c.HostURL), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/servers", c.HostURL), strings.NewReader(string(rb)))
 	if err != nil {
 		return nil, err, nil
 	}
@@ -66,12 +67,12 @@ func (c *Client) CreateServer(createServer *ServerCreateRequest) (*ServerRespons
 	return &server, nil, res
 }
 
-func (c *Client) UpdateServer(serverId string, serverUpdates ServerUpdateRequest) (*Server, diag.Diagnostics, *http.Response) {
+func (c *Client) UpdateServer(ctx context.Context, serverId string, serverUpdates ServerUpdateRequest) (*Server, diag.Diagnostics, *http.Response) {
 	rb, err := json.Marshal(serverUpdates)
 	if err != nil {
 		return nil, diag.Errorf("Whoops: %s", err), nil
 	}
-	req, err := http.NewRequest(http.MethodPut, fmt.Sprintf("%s/servers/%s", c.HostURL, serverId), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("%s/servers/%s", c.HostURL, serverId), strings.NewReader(string(rb)))
 	if err != nil {
 		return nil, diag.Errorf("Whoops: %s", err), nil
 	}
@@ -90,8 +91,8 @@ func (c *Client) UpdateServer(serverId string, serverUpdates ServerUpdateRequest
 	return &server.Server, nil, res
 }
 
-func (c *Client) DeleteServer(serverId string) (error, *http.Response) {
-	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/servers/%s", c.HostURL, serverId), nil)
+func (c *Client) DeleteServer(ctx context.Context, serverId string) (error, *http.Response) {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/servers/%s", c.HostURL, serverId), nil)
 	if err != nil {
 		return err, nil
 	}
@@ -107,8 +108,8 @@ func (c *Client) DeleteServer(serverId string) (error, *http.Response) {
 	return nil, res
 }
 
-func (c *Client) EnableOpcache(serverId string) diag.Diagnostics {
-	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/servers/%s/php/opcache", c.HostURL, serverId), nil)
+func (c *Client) EnableOpcache(ctx context.Context, serverId string) diag.Diagnostics {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/servers/%s/php/opcache", c.HostURL, serverId), nil)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -121,8 +122,8 @@ func (c *Client) EnableOpcache(serverId string) diag.Diagnostics {
 	return nil
 }
 
-func (c *Client) DisableOpcache(serverId string) diag.Diagnostics {
-	req, err := http.NewRequest(http.MethodDelete, fmt.Sprintf("%s/servers/%s/php/opcache", c.HostURL, serverId), nil)
+func (c *Client) DisableOpcache(ctx context.Context, serverId string) diag.Diagnostics {
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("%s/servers/%s/php/opcache", c.HostURL, serverId), nil)
 	if err != nil {
 		return diag.FromErr(err)
 	}
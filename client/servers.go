@@ -1 +1,31 @@
 package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"log"
+	"net/http"
+)
+
+func (c *Client) ListServers(ctx context.Context) ([]Server, diag.Diagnostics) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/servers", c.HostURL), nil)
+	if err != nil {
+		return nil, diag.Errorf("Whoops: %s", err)
+	}
+
+	body, err, _ := c.doRequest(req)
+	log.Printf("[DEBUG] [ListServers] - body: %#v", string(body))
+	if err != nil {
+		return nil, diag.Errorf("Whoops: %s", err)
+	}
+
+	var serversResponse ServersResponse
+	err = json.Unmarshal(body, &serversResponse)
+	if err != nil {
+		return nil, diag.Errorf("Whoops: %s", err)
+	}
+
+	return serversResponse.Servers, nil
+}
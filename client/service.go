@@ -0,0 +1,43 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"log"
+	"net/http"
+	"strings"
+)
+
+func (c *Client) RestartService(ctx context.Context, serverId string, service string, serviceAction ServiceActionRequest) diag.Diagnostics {
+	return c.runServiceAction(ctx, serverId, service, "restart", serviceAction)
+}
+
+func (c *Client) StartService(ctx context.Context, serverId string, service string, serviceAction ServiceActionRequest) diag.Diagnostics {
+	return c.runServiceAction(ctx, serverId, service, "start", serviceAction)
+}
+
+func (c *Client) StopService(ctx context.Context, serverId string, service string, serviceAction ServiceActionRequest) diag.Diagnostics {
+	return c.runServiceAction(ctx, serverId, service, "stop", serviceAction)
+}
+
+func (c *Client) runServiceAction(ctx context.Context, serverId string, service string, action string, serviceAction ServiceActionRequest) diag.Diagnostics {
+	log.Printf("[INFO] [LARAVELFORGE:runServiceAction] Service: %s, Action: %s", service, action)
+	rb, err := json.Marshal(serviceAction)
+	if err != nil {
+		return diag.Errorf("Whoops: %s", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/servers/%s/%s/%s", c.HostURL, serverId, service, action), strings.NewReader(string(rb)))
+	if err != nil {
+		return diag.Errorf("Whoops: %s", err)
+	}
+
+	err = c.doRequestEmptyBody(req)
+	if err != nil {
+		return diag.Errorf("Whoops: %s", err)
+	}
+
+	return nil
+}
@@ -1,6 +1,7 @@
 package client
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -11,8 +12,8 @@ import (
 )
 
 // GetSite - Returns a specific site in a project
-func (c *Client) GetSite(serverId string, siteId string) (*Site, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/servers/%s/sites/%s", c.HostURL, serverId, siteId), nil)
+func (c *Client) GetSite(ctx context.Context, serverId string, siteId string) (*Site, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/servers/%s/sites/%s", c.HostURL, serverId, siteId), nil)
 	log.Printf("[INFO] [LARAVELFORGE:GetSite] SiteId: %s", siteId)
 	if err != nil {
 		return nil, err
@@ -33,14 +34,36 @@ func (c *Client) GetSite(serverId string, siteId string) (*Site, error) {
 	return &site.Site, nil
 }
 
-func (c *Client) CreateSite(serverId string, createSite *SiteCreateRequest) (*Site, diag.Diagnostics) {
+// ListSites - Returns all sites on a server
+func (c *Client) ListSites(ctx context.Context, serverId string) ([]Site, diag.Diagnostics) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/servers/%s/sites", c.HostURL, serverId), nil)
+	if err != nil {
+		return nil, diag.Errorf("Whoops: %s", err)
+	}
+
+	body, err, _ := c.doRequest(req)
+	log.Printf("[DEBUG] [ListSites] - body: %#v, Server ID: %s", string(body), serverId)
+	if err != nil {
+		return nil, diag.Errorf("Whoops: %s", err)
+	}
+
+	var sitesResponse SitesResponse
+	err = json.Unmarshal(body, &sitesResponse)
+	if err != nil {
+		return nil, diag.Errorf("Whoops: %s", err)
+	}
+
+	return sitesResponse.Sites, nil
+}
+
+func (c *Client) CreateSite(ctx context.Context, serverId string, createSite *SiteCreateRequest) (*Site, diag.Diagnostics) {
 	log.Printf("[INFO] [LARAVELFORGE:CreateSite]")
 	rb, err := json.Marshal(createSite)
 	if err != nil {
 		return nil, diag.Errorf("Whoops: %s", err)
 	}
 
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/servers/%s/sites", c.HostURL, serverId), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/servers/%s/sites", c.HostURL, serverId), strings.NewReader(string(rb)))
 	if err != nil {
 		return nil, diag.Errorf("Whoops: %s", err)
 	}
@@ -59,12 +82,12 @@ func (c *Client) CreateSite(serverId string, createSite *SiteCreateRequest) (*Si
 	return &site.Site, nil
 }
 
-func (c *Client) UpdateSite(serverId string, siteId string, siteUpdates SiteUpdateRequest) (*Site, diag.Diagnostics) {
+func (c *Client) UpdateSite(ctx context.Context, serverId string, siteId string, siteUpdates SiteUpdateRequest) (*Site, diag.Diagnostics) {
 	rb, err := json.Marshal(siteUpdates)
 	if err != nil {
 		return nil, diag.Errorf("Whoops: %s", err)
 	}
-	req, err := http.NewRequest(http.MethodPut, fmt.Sprintf("%s/servers/%s/sites/%s", c.HostURL, serverId, siteId), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("%s/servers/%s/sites/%s", c.HostURL, serverId, siteId), strings.NewReader(string(rb)))
 	if err != nil {
 		return nil, diag.Errorf("Whoops: %s", err)
 	}
@@ -83,12 +106,12 @@ func (c *Client) UpdateSite(serverId string, siteId string, siteUpdates SiteUpda
 	return &site.Site, nil
 }
 
-func (c *Client) UpdateSitePhpVersion(serverId string, siteId string, phpVersion SiteUpdatePhpVersion) (*Site, diag.Diagnostics) {
+func (c *Client) UpdateSitePhpVersion(ctx context.Context, serverId string, siteId string, phpVersion SiteUpdatePhpVersion) (*Site, diag.Diagnostics) {
 	rb, err := json.Marshal(phpVersion)
 	if err != nil {
 		return nil, diag.Errorf("Whoops: %s", err)
 	}
-	req, err := http.NewRequest(http.MethodPut, fmt.Sprintf("%s/servers/%s/sites/%s/php", c.HostURL, serverId, siteId), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("%s/servers/%s/sites/%s/php", c.HostURL, serverId, siteId), strings.NewReader(string(rb)))
 	if err != nil {
 		return nil, diag.Errorf("Whoops: %s", err)
 	}
@@ -98,7 +121,7 @@ func (c *Client) UpdateSitePhpVersion(serverId string, siteId string, phpVersion
 		return nil, diag.Errorf("Whoops: %s", err)
 	}
 
-	site, err := c.GetSite(serverId, siteId)
+	site, err := c.GetSite(ctx, serverId, siteId)
 	if err != nil {
 		return nil, diag.Errorf("Whoops: %s", err)
 	}
@@ -106,8 +129,8 @@ func (c *Client) UpdateSitePhpVersion(serverId string, siteId string, phpVersion
 	return site, nil
 }
 
-func (c *Client) DeleteSite(serverId string, siteId string) error {
-	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/servers/%s/sites/%s", c.HostURL, serverId, siteId), nil)
+func (c *Client) DeleteSite(ctx context.Context, serverId string, siteId string) error {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/servers/%s/sites/%s", c.HostURL, serverId, siteId), nil)
 	if err != nil {
 		return err
 	}
@@ -122,3 +145,61 @@ func (c *Client) DeleteSite(serverId string, siteId string) error {
 
 	return nil
 }
+
+func (c *Client) InstallSiteRepository(ctx context.Context, serverId string, siteId string, installRepository *SiteRepositoryInstallRequest) (*Site, diag.Diagnostics) {
+	log.Printf("[INFO] [LARAVELFORGE:InstallSiteRepository]")
+	rb, err := json.Marshal(installRepository)
+	if err != nil {
+		return nil, diag.Errorf("Whoops: %s", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/servers/%s/sites/%s/git", c.HostURL, serverId, siteId), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, diag.Errorf("Whoops: %s", err)
+	}
+
+	body, err, _ := c.doRequest(req)
+	if err != nil {
+		return nil, diag.Errorf("Whoopsy: %s", err)
+	}
+
+	site := SiteGet{}
+	err = json.Unmarshal(body, &site)
+	if err != nil {
+		return nil, diag.Errorf("Whoops: %s", err)
+	}
+
+	return &site.Site, nil
+}
+
+func (c *Client) UpdateSiteRepositoryBranch(ctx context.Context, serverId string, siteId string, branchUpdate SiteRepositoryBranchUpdateRequest) (*Site, diag.Diagnostics) {
+	rb, err := json.Marshal(branchUpdate)
+	if err != nil {
+		return nil, diag.Errorf("Whoops: %s", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("%s/servers/%s/sites/%s/git/branch", c.HostURL, serverId, siteId), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, diag.Errorf("Whoops: %s", err)
+	}
+
+	err = c.doRequestEmptyBody(req)
+	if err != nil {
+		return nil, diag.Errorf("Whoops: %s", err)
+	}
+
+	site, err := c.GetSite(ctx, serverId, siteId)
+	if err != nil {
+		return nil, diag.Errorf("Whoops: %s", err)
+	}
+
+	return site, nil
+}
+
+func (c *Client) DestroySiteRepository(ctx context.Context, serverId string, siteId string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("%s/servers/%s/sites/%s/git", c.HostURL, serverId, siteId), nil)
+	if err != nil {
+		return err
+	}
+
+	return c.doRequestEmptyBody(req)
+}
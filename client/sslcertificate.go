@@ -1,6 +1,7 @@
 package client
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -10,8 +11,8 @@ import (
 	"strings"
 )
 
-func (c *Client) GetCertificate(serverId string, siteId string, certificateId string) (*Certificate, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/servers/%s/sites/%s/certificates/%s", c.HostURL, serverId, siteId, certificateId), nil)
+func (c *Client) GetCertificate(ctx context.Context, serverId string, siteId string, certificateId string) (*Certificate, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/servers/%s/sites/%s/certificates/%s", c.HostURL, serverId, siteId, certificateId), nil)
 
 	log.Printf("[INFO] [LARAVELFORGE:GetCertificate] Certificate ID: %s", certificateId)
 	if err != nil {
@@ -33,14 +34,14 @@ func (c *Client) GetCertificate(serverId string, siteId string, certificateId st
 	return &certificate.Certificate, nil
 }
 
-func (c *Client) ObtainLetsEncryptSslCertificate(serverId string, siteId string, createSslCertificate *SslCertificateCreateRequest) (*Certificate, diag.Diagnostics) {
+func (c *Client) ObtainLetsEncryptSslCertificate(ctx context.Context, serverId string, siteId string, createSslCertificate *SslCertificateCreateRequest) (*Certificate, diag.Diagnostics) {
 	log.Printf("[INFO] [LARAVELFORGE:CreateSslCertificate]")
 	rb, err := json.Marshal(createSslCertificate)
 	if err != nil {
 		return nil, diag.Errorf("Whoops: %s", err)
 	}
 
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/servers/%s/sites/%s/certificates/letsencrypt", c.HostURL, serverId, siteId), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/servers/%s/sites/%s/certificates/letsencrypt", c.HostURL, serverId, siteId), strings.NewReader(string(rb)))
 	log.Printf("[INFO] [LARAVELFORGE:GetCertificate] Certificate request: %#v, rb: %#v", req, strings.NewReader(string(rb)))
 	//return nil, nil
 	if err != nil {
@@ -61,14 +62,14 @@ func (c *Client) ObtainLetsEncryptSslCertificate(serverId string, siteId string,
 	return &certificate.Certificate, nil
 }
 
-func (c *Client) CloneExistingSslCertificate(serverId string, siteId string, request *SslCertificateCloneRequest) (*Certificate, diag.Diagnostics) {
+func (c *Client) CloneExistingSslCertificate(ctx context.Context, serverId string, siteId string, request *SslCertificateCloneRequest) (*Certificate, diag.Diagnostics) {
 	log.Printf("[INFO] [LARAVELFORGE:CloneExistingSslCertificate]")
 	rb, err := json.Marshal(request)
 	if err != nil {
 		return nil, diag.Errorf("Whoops: %s", err)
 	}
 
-	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/servers/%s/sites/%s/certificates", c.HostURL, serverId, siteId), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/servers/%s/sites/%s/certificates", c.HostURL, serverId, siteId), strings.NewReader(string(rb)))
 	log.Printf("[INFO] [LARAVELFORGE:CloneExistingSslCertificate] Certificate request: %#v, rb: %#v", req, strings.NewReader(string(rb)))
 
 	if err != nil {
@@ -89,14 +90,14 @@ func (c *Client) CloneExistingSslCertificate(serverId string, siteId string, req
 	return &certificate.Certificate, nil
 }
 
-func (c *Client) InstallExistingSslCertificate(serverId string, siteId string, request *SslCertificateInstallExistingRequest) (*Certificate, diag.Diagnostics) {
+func (c *Client) InstallExistingSslCertificate(ctx context.Context, serverId string, siteId string, request *SslCertificateInstallExistingRequest) (*Certificate, diag.Diagnostics) {
 	log.Printf("[INFO] [LARAVELFORGE:InstallExistingSslCertificate]")
 	rb, err := json.Marshal(request)
 	if err != nil {
 		return nil, diag.Errorf("Whoops: %s", err)
 	}
 
-	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/servers/%s/sites/%s/certificates", c.HostURL, serverId, siteId), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/servers/%s/sites/%s/certificates", c.HostURL, serverId, siteId), strings.NewReader(string(rb)))
 	log.Printf("[INFO] [LARAVELFORGE:InstallExistingSslCertificate] Certificate request: %#v, rb: %#v", req, strings.NewReader(string(rb)))
 
 	if err != nil {
@@ -117,10 +118,10 @@ func (c *Client) InstallExistingSslCertificate(serverId string, siteId string, r
 	return &certificate.Certificate, nil
 }
 
-func (c *Client) ActivateCertificate(serverId string, siteId string, certificateId string) diag.Diagnostics {
+func (c *Client) ActivateCertificate(ctx context.Context, serverId string, siteId string, certificateId string) diag.Diagnostics {
 	log.Printf("[INFO] [LARAVELFORGE:ActivateCertificate]")
 
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/servers/%s/sites/%s/certificates/%s/activate", c.HostURL, serverId, siteId, certificateId), nil)
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/servers/%s/sites/%s/certificates/%s/activate", c.HostURL, serverId, siteId, certificateId), nil)
 	if err != nil {
 		return diag.Errorf("Whoops: %s", err)
 	}
@@ -138,7 +139,7 @@ func (c *Client) ActivateCertificate(serverId string, siteId string, certificate
 //	if err != nil {
 //		return nil, diag.Errorf("Whoops: %s", err)
 //	}
-//	req, err := http.NewRequest(http.MethodPut, fmt.Sprintf("%s/servers/%s/sites/%s", c.HostURL, serverId, siteId), strings.NewReader(string(rb)))
+//	req, err := http.NewRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("%s/servers/%s/sites/%s", c.HostURL, serverId, siteId), strings.NewReader(string(rb)))
 //	if err != nil {
 //		return nil, diag.Errorf("Whoops: %s", err)
 //	}
@@ -157,8 +158,8 @@ func (c *Client) ActivateCertificate(serverId string, siteId string, certificate
 //	return &site.Site, nil
 //}
 
-func (c *Client) DeleteCertificate(serverId string, siteId string, certificateId string) error {
-	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/servers/%s/sites/%s/certificates/%s", c.HostURL, serverId, siteId, certificateId), nil)
+func (c *Client) DeleteCertificate(ctx context.Context, serverId string, siteId string, certificateId string) error {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/servers/%s/sites/%s/certificates/%s", c.HostURL, serverId, siteId, certificateId), nil)
 	if err != nil {
 		return err
 	}
@@ -1,13 +1,14 @@
 package client
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
 )
 
-func (c *Client) GetUser(userID string) (*User, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/users/%s", c.HostURL, userID), nil)
+func (c *Client) GetUser(ctx context.Context, userID string) (*User, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/users/%s", c.HostURL, userID), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,98 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"log"
+	"net/http"
+	"strings"
+)
+
+func (c *Client) GetWebhook(ctx context.Context, serverId string, siteId string, webhookId string) (*Webhook, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/servers/%s/sites/%s/webhooks/%s", c.HostURL, serverId, siteId, webhookId), nil)
+	log.Printf("[INFO] [LARAVELFORGE:GetWebhook] WebhookId: %s", webhookId)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err, _ := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	webhook := WebhookResponse{}
+	err = json.Unmarshal(body, &webhook)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("[INFO] [LARAVELFORGE:GetWebhook] Webhook: %#v, Body: %#v", &webhook, body)
+
+	return &webhook.Webhook, nil
+}
+
+func (c *Client) ListWebhooks(ctx context.Context, serverId string, siteId string) ([]Webhook, diag.Diagnostics) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/servers/%s/sites/%s/webhooks", c.HostURL, serverId, siteId), nil)
+	if err != nil {
+		return nil, diag.Errorf("Whoops: %s", err)
+	}
+
+	body, err, _ := c.doRequest(req)
+	log.Printf("[DEBUG] [ListWebhooks] - body: %#v, Site ID: %s", string(body), siteId)
+	if err != nil {
+		return nil, diag.Errorf("Whoops: %s", err)
+	}
+
+	var webhooksResponse WebhooksResponse
+	err = json.Unmarshal(body, &webhooksResponse)
+	if err != nil {
+		return nil, diag.Errorf("Whoops: %s", err)
+	}
+
+	return webhooksResponse.Webhooks, nil
+}
+
+func (c *Client) CreateWebhook(ctx context.Context, serverId string, siteId string, createWebhook *CreateWebhookRequest) (*Webhook, diag.Diagnostics) {
+	log.Printf("[INFO] [LARAVELFORGE:CreateWebhook]")
+	rb, err := json.Marshal(createWebhook)
+	if err != nil {
+		return nil, diag.Errorf("Whoops: %s", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/servers/%s/sites/%s/webhooks", c.HostURL, serverId, siteId), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, diag.Errorf("Whoops: %s", err)
+	}
+
+	body, err, _ := c.doRequest(req)
+	if err != nil {
+		return nil, diag.Errorf("Whoopsy: %s", err)
+	}
+
+	webhook := WebhookResponse{}
+	err = json.Unmarshal(body, &webhook)
+	if err != nil {
+		return nil, diag.Errorf("Whoops: %s", err)
+	}
+
+	return &webhook.Webhook, nil
+}
+
+func (c *Client) DeleteWebhook(ctx context.Context, serverId string, siteId string, webhookId string) error {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/servers/%s/sites/%s/webhooks/%s", c.HostURL, serverId, siteId, webhookId), nil)
+	if err != nil {
+		return err
+	}
+	body, err, _ := c.doRequest(req)
+	if err != nil {
+		return err
+	}
+
+	if string(body) != "" {
+		return errors.New(string(body))
+	}
+
+	return nil
+}
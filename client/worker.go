@@ -0,0 +1,116 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"log"
+	"net/http"
+	"strings"
+)
+
+// FlexibleBool - Forge returns some flags as booleans and others as 0/1 integers
+type FlexibleBool bool
+
+func (b *FlexibleBool) UnmarshalJSON(data []byte) error {
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+
+	switch v := value.(type) {
+	case bool:
+		*b = FlexibleBool(v)
+	case float64:
+		*b = v != 0
+	case string:
+		*b = v == "1" || v == "true"
+	default:
+		*b = false
+	}
+
+	return nil
+}
+
+func (c *Client) GetWorker(ctx context.Context, serverId string, siteId string, workerId string) (*Worker, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/servers/%s/sites/%s/workers/%s", c.HostURL, serverId, siteId, workerId), nil)
+	log.Printf("[INFO] [LARAVELFORGE:GetWorker] WorkerId: %s", workerId)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err, _ := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	worker := WorkerResponse{}
+	err = json.Unmarshal(body, &worker)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("[INFO] [LARAVELFORGE:GetWorker] Worker: %#v, Body: %#v", &worker, body)
+
+	return &worker.Worker, nil
+}
+
+func (c *Client) CreateWorker(ctx context.Context, serverId string, siteId string, createWorker *CreateWorkerRequest) (*Worker, diag.Diagnostics) {
+	log.Printf("[INFO] [LARAVELFORGE:CreateWorker]")
+	rb, err := json.Marshal(createWorker)
+	if err != nil {
+		return nil, diag.Errorf("Whoops: %s", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/servers/%s/sites/%s/workers", c.HostURL, serverId, siteId), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, diag.Errorf("Whoops: %s", err)
+	}
+
+	body, err, _ := c.doRequest(req)
+	if err != nil {
+		return nil, diag.Errorf("Whoopsy: %s", err)
+	}
+
+	worker := WorkerResponse{}
+	err = json.Unmarshal(body, &worker)
+	if err != nil {
+		return nil, diag.Errorf("Whoops: %s", err)
+	}
+
+	return &worker.Worker, nil
+}
+
+func (c *Client) RestartWorker(ctx context.Context, serverId string, siteId string, workerId string) diag.Diagnostics {
+	log.Printf("[INFO] [LARAVELFORGE:RestartWorker] WorkerId: %s", workerId)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/servers/%s/sites/%s/workers/%s/restart", c.HostURL, serverId, siteId, workerId), nil)
+	if err != nil {
+		return diag.Errorf("Whoops: %s", err)
+	}
+
+	err = c.doRequestEmptyBody(req)
+	if err != nil {
+		return diag.Errorf("Whoopsy: %s", err)
+	}
+
+	return nil
+}
+
+func (c *Client) DeleteWorker(ctx context.Context, serverId string, siteId string, workerId string) error {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/servers/%s/sites/%s/workers/%s", c.HostURL, serverId, siteId, workerId), nil)
+	if err != nil {
+		return err
+	}
+	body, err, _ := c.doRequest(req)
+	if err != nil {
+		return err
+	}
+
+	if string(body) != "" {
+		return errors.New(string(body))
+	}
+
+	return nil
+}
@@ -0,0 +1,133 @@
+package laravelforge
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"log"
+	"sort"
+	"strconv"
+	lf "tonning/terraform-provider-laravelforge/client"
+)
+
+func dataSourceBackups() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceBackupsRead,
+		Schema: map[string]*schema.Schema{
+			"server_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"backup_configuration_id": {
+				Type:        schema.TypeString,
+				Description: "Only list the backups of this backup configuration.",
+				Optional:    true,
+			},
+			"limit": {
+				Type:        schema.TypeInt,
+				Description: "The maximum number of backups to list, most recent first.",
+				Optional:    true,
+				Default:     10,
+			},
+			"backups": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"backup_configuration_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"restore_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"archive_path": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"size": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"uuid": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"duration": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceBackupsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*lf.Client)
+
+	var diags diag.Diagnostics
+
+	serverId := d.Get("server_id").(string)
+	backupConfigurationId := d.Get("backup_configuration_id").(string)
+
+	backupConfigurations, err := c.ListBackupConfigurations(ctx, serverId)
+	log.Printf("[INFO] [LARAVELFORGE:dataSourceBackupsRead] Backup Configurations: %#v", backupConfigurations)
+	if err != nil {
+		return err
+	}
+
+	var backups []lf.Backup
+	for _, backupConfiguration := range backupConfigurations {
+		if backupConfigurationId != "" && strconv.Itoa(backupConfiguration.Id) != backupConfigurationId {
+			continue
+		}
+
+		backups = append(backups, backupConfiguration.Backups...)
+	}
+
+	sort.Slice(backups, func(i, j int) bool {
+		return backups[i].Id > backups[j].Id
+	})
+
+	if limit := d.Get("limit").(int); limit > 0 && len(backups) > limit {
+		backups = backups[:limit]
+	}
+
+	var items []interface{}
+	for _, backup := range backups {
+		items = append(items, map[string]interface{}{
+			"id":                      backup.Id,
+			"backup_configuration_id": backup.BackupConfigurationId,
+			"status":                  backup.Status,
+			"restore_status":          backup.RestoreStatus,
+			"archive_path":            backup.ArchivePath,
+			"size":                    backup.Size,
+			"uuid":                    backup.Uuid,
+			"duration":                backup.Duration,
+			"date":                    backup.Date,
+		})
+	}
+
+	if err := d.Set("backups", items); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(serverId)
+
+	return diags
+}
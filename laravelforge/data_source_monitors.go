@@ -0,0 +1,97 @@
+package laravelforge
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"log"
+	lf "tonning/terraform-provider-laravelforge/client"
+)
+
+func dataSourceMonitors() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceMonitorsRead,
+		Schema: map[string]*schema.Schema{
+			"server_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"monitors": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"operator": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"threshold": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"minutes": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"state_changed_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceMonitorsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*lf.Client)
+
+	var diags diag.Diagnostics
+
+	serverId := d.Get("server_id").(string)
+
+	monitors, err := c.ListMonitors(ctx, serverId)
+	log.Printf("[INFO] [LARAVELFORGE:dataSourceMonitorsRead] Monitors: %#v", monitors)
+	if err != nil {
+		return err
+	}
+
+	var items []interface{}
+	for _, monitor := range monitors {
+		items = append(items, map[string]interface{}{
+			"id":               monitor.Id,
+			"type":             monitor.Type,
+			"operator":         monitor.Operator,
+			"threshold":        monitor.Threshold,
+			"minutes":          monitor.Minutes,
+			"state":            monitor.State,
+			"state_changed_at": monitor.StateChangedAt,
+			"status":           monitor.Status,
+		})
+	}
+
+	if err := d.Set("monitors", items); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(serverId)
+
+	return diags
+}
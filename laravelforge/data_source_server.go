@@ -5,111 +5,163 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"log"
-	"net/http"
 	"strconv"
-	"time"
 	lf "tonning/terraform-provider-laravelforge/client"
 )
 
 func dataSourceServer() *schema.Resource {
+	attributes := serverAttributes()
+	attributes["id"] = &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Computed:     true,
+		ExactlyOneOf: []string{"id", "name"},
+	}
+	attributes["name"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Description:  "The name of the server to look up, as an alternative to `id`.",
+		Optional:     true,
+		Computed:     true,
+		ExactlyOneOf: []string{"id", "name"},
+	}
+	attributes["network"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "An array of server IDs that the server should be able to connect to.",
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+	}
+
 	return &schema.Resource{
 		ReadContext: dataSourceServerRead,
-		Schema: map[string]*schema.Schema{
-			"id": {
-				Type:     schema.TypeInt,
-				Required: true,
-			},
-			"credential_id": {
-				Type:     schema.TypeString,
-				Computed: true,
-			},
-			"name": {
-				Type:     schema.TypeString,
-				Computed: true,
-			},
-			"type": {
-				Type:     schema.TypeString,
-				Computed: true,
-			},
-			"cloud_provider": {
-				Type:     schema.TypeString,
-				Computed: true,
-			},
-			"cloud_provider_id": {
-				Type:     schema.TypeString,
-				Computed: true,
-			},
-			"region": {
-				Type:     schema.TypeString,
-				Computed: true,
-			},
-			"ubuntu_version": {
-				Type:     schema.TypeString,
-				Computed: true,
-			},
-			"db_status": {
-				Type:     schema.TypeString,
-				Computed: true,
-			},
-			"redis_status": {
-				Type:     schema.TypeString,
-				Computed: true,
-			},
-			"php_version": {
-				Type:     schema.TypeString,
-				Computed: true,
-			},
-			"php_cli_version": {
-				Type:     schema.TypeString,
-				Computed: true,
-			},
-			"database_type": {
-				Type:     schema.TypeString,
-				Computed: true,
-			},
-			"ip_address": {
-				Type:     schema.TypeString,
-				Computed: true,
-			},
-			"ssh_port": {
-				Type:     schema.TypeInt,
-				Computed: true,
-			},
-			"private_ip_address": {
-				Type:     schema.TypeString,
-				Computed: true,
-			},
-			"local_public_key": {
-				Type:     schema.TypeString,
-				Computed: true,
-			},
-			"blackfire_status": {
-				Type:     schema.TypeString,
-				Computed: true,
-			},
-			"papertrail_status": {
-				Type:     schema.TypeString,
-				Computed: true,
-			},
-			"revoked": {
-				Type:     schema.TypeBool,
-				Computed: true,
-			},
-			"created_at": {
-				Type:     schema.TypeString,
-				Computed: true,
-			},
-			"is_ready": {
-				Type:     schema.TypeBool,
-				Computed: true,
-			},
-			"network": {
-				Type:        schema.TypeList,
-				Description: "An array of server IDs that the server should be able to connect to.",
-				Optional:    true,
-				Elem:        &schema.Schema{Type: schema.TypeString},
+		Schema:      attributes,
+	}
+}
+
+// serverAttributes returns the computed attributes shared by the server data sources.
+func serverAttributes() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"credential_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"name": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"type": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"cloud_provider": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"cloud_provider_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"region": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"ubuntu_version": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"db_status": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"redis_status": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"php_version": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"php_cli_version": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"database_type": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"ip_address": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"ssh_port": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"private_ip_address": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"local_public_key": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"blackfire_status": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"papertrail_status": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"revoked": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"created_at": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"is_ready": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"php_versions": {
+			Type:        schema.TypeList,
+			Description: "The PHP versions installed on the server.",
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"version": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"displayable_version": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"binary_name": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"status": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"used_as_default": {
+						Type:     schema.TypeBool,
+						Computed: true,
+					},
+					"used_on_cli": {
+						Type:     schema.TypeBool,
+						Computed: true,
+					},
+				},
 			},
 		},
+		"tags": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
 	}
 }
 
@@ -118,44 +170,89 @@ func dataSourceServerRead(ctx context.Context, d *schema.ResourceData, m interfa
 
 	var diags diag.Diagnostics
 
-	serverId := strconv.Itoa(d.Get("id").(int))
+	var server *lf.Server
+	if name, ok := d.GetOk("name"); ok && d.Get("id").(int) == 0 {
+		var err diag.Diagnostics
+		server, err = findServerByName(ctx, c, name.(string))
+		if err != nil {
+			return err
+		}
+	} else {
+		serverId := strconv.Itoa(d.Get("id").(int))
 
-	server, err, response := c.GetServer(serverId)
-	log.Printf("[INFO] [LARAVELFORGE:dataSourceServerRead] Server: %#v", server)
+		var err error
+		server, err, _ = c.GetServer(ctx, serverId)
+		log.Printf("[INFO] [LARAVELFORGE:dataSourceServerRead] Server: %#v", server)
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
 
+	log.Printf("[INFO] [LARAVELFORGE:dataSourceServerRead] 2 Server: %#v", server)
+	d.SetId(strconv.Itoa(server.Id))
+	d.Set("id", server.Id)
+	for key, value := range flattenServer(server) {
+		d.Set(key, value)
+	}
+
+	return diags
+}
+
+func findServerByName(ctx context.Context, c *lf.Client, name string) (*lf.Server, diag.Diagnostics) {
+	servers, err := c.ListServers(ctx)
 	if err != nil {
-		return diag.FromErr(err)
+		return nil, err
 	}
 
-	if response.StatusCode == http.StatusTooManyRequests {
-		time.Sleep(time.Second * 30)
+	var found []lf.Server
+	for _, server := range servers {
+		if server.Name == name {
+			found = append(found, server)
+		}
+	}
 
-		return dataSourceServerRead(ctx, d, m)
+	if len(found) == 0 {
+		return nil, diag.Errorf("No server found with name %q.", name)
 	}
 
-	log.Printf("[INFO] [LARAVELFORGE:dataSourceServerRead] 2 Server: %#v", server)
-	d.SetId(strconv.Itoa(server.Id))
-	d.Set("credential_id", server.CredentialId)
-	d.Set("name", server.Name)
-	d.Set("type", server.Type)
-	d.Set("cloud_provider", server.Provider)
-	d.Set("cloud_provider_id", server.ProviderId)
-	d.Set("region", server.Region)
-	d.Set("ubuntu_version", server.UbuntuVersion)
-	d.Set("db_status", server.DbStatus)
-	d.Set("redis_status", server.RedisStatus)
-	d.Set("php_version", server.PhpVersion)
-	d.Set("php_cli_version", server.PhpCliVersion)
-	d.Set("database_type", server.DatabaseType)
-	d.Set("ip_address", server.IpAddress)
-	d.Set("ssh_port", server.SshPort)
-	d.Set("private_ip_address", server.PrivateIpAddress)
-	d.Set("local_public_key", server.LocalPublicKey)
-	d.Set("blackfire_status", server.BlackfireStatus)
-	d.Set("papertrail_status", server.PapertrailStatus)
-	d.Set("revoked", server.Revoked)
-	d.Set("created_at", server.CreatedAt)
-	d.Set("is_ready", server.IsReady)
+	if len(found) > 1 {
+		return nil, diag.Errorf("Found %d servers with name %q, use \"id\" instead.", len(found), name)
+	}
 
-	return diags
+	return &found[0], nil
+}
+
+// flattenServer maps a server onto the attributes returned by serverAttributes.
+func flattenServer(server *lf.Server) map[string]interface{} {
+	var tags []string
+	for _, tag := range server.Tags {
+		tags = append(tags, tag.Name)
+	}
+
+	return map[string]interface{}{
+		"credential_id":      server.CredentialId,
+		"name":               server.Name,
+		"type":               server.Type,
+		"cloud_provider":     server.Provider,
+		"cloud_provider_id":  server.ProviderId,
+		"region":             server.Region,
+		"ubuntu_version":     server.UbuntuVersion,
+		"db_status":          server.DbStatus,
+		"redis_status":       server.RedisStatus,
+		"php_version":        server.PhpVersion,
+		"php_cli_version":    server.PhpCliVersion,
+		"database_type":      server.DatabaseType,
+		"ip_address":         server.IpAddress,
+		"ssh_port":           server.SshPort,
+		"private_ip_address": server.PrivateIpAddress,
+		"local_public_key":   server.LocalPublicKey,
+		"blackfire_status":   server.BlackfireStatus,
+		"papertrail_status":  server.PapertrailStatus,
+		"revoked":            server.Revoked,
+		"created_at":         server.CreatedAt,
+		"is_ready":           server.IsReady,
+		"php_versions":       flattenPhpVersions(server.PhpVersions),
+		"tags":               tags,
+	}
 }
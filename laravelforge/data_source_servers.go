@@ -0,0 +1,129 @@
+package laravelforge
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"hash/crc32"
+	"log"
+	"regexp"
+	"strconv"
+	"strings"
+	lf "tonning/terraform-provider-laravelforge/client"
+)
+
+func dataSourceServers() *schema.Resource {
+	server := serverAttributes()
+	server["id"] = &schema.Schema{
+		Type:     schema.TypeInt,
+		Computed: true,
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceServersRead,
+		Schema: map[string]*schema.Schema{
+			"name_regex": {
+				Type:         schema.TypeString,
+				Description:  "Only list servers whose name matches this regular expression.",
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			"cloud_provider": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"tag": {
+				Type:        schema.TypeString,
+				Description: "Only list servers with this tag.",
+				Optional:    true,
+			},
+			"servers": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: server,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceServersRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*lf.Client)
+
+	var diags diag.Diagnostics
+
+	servers, err := c.ListServers(ctx)
+	log.Printf("[INFO] [LARAVELFORGE:dataSourceServersRead] Servers: %#v", servers)
+	if err != nil {
+		return err
+	}
+
+	var nameRegex *regexp.Regexp
+	if v, ok := d.GetOk("name_regex"); ok {
+		nameRegex = regexp.MustCompile(v.(string))
+	}
+
+	cloudProvider := d.Get("cloud_provider").(string)
+	region := d.Get("region").(string)
+	serverType := d.Get("type").(string)
+	tag := d.Get("tag").(string)
+
+	var items []interface{}
+	for i := range servers {
+		server := &servers[i]
+
+		if nameRegex != nil && !nameRegex.MatchString(server.Name) {
+			continue
+		}
+
+		if cloudProvider != "" && server.Provider != cloudProvider {
+			continue
+		}
+
+		if region != "" && server.Region != region {
+			continue
+		}
+
+		if serverType != "" && server.Type != serverType {
+			continue
+		}
+
+		if tag != "" && !serverHasTag(server, tag) {
+			continue
+		}
+
+		item := flattenServer(server)
+		item["id"] = server.Id
+		items = append(items, item)
+	}
+
+	if err := d.Set("servers", items); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The same filters always list the same servers, so they identify the data source.
+	filters := strings.Join([]string{d.Get("name_regex").(string), cloudProvider, region, serverType, tag}, "\n")
+	d.SetId(strconv.FormatUint(uint64(crc32.ChecksumIEEE([]byte(filters))), 10))
+
+	return diags
+}
+
+func serverHasTag(server *lf.Server, tag string) bool {
+	for _, serverTag := range server.Tags {
+		if serverTag.Name == tag {
+			return true
+		}
+	}
+
+	return false
+}
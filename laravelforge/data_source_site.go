@@ -4,34 +4,97 @@ import (
 	"context"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"log"
 	"strconv"
 	lf "tonning/terraform-provider-laravelforge/client"
 )
 
 func dataSourceSite() *schema.Resource {
+	attributes := siteAttributes()
+	attributes["server_id"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+	}
+	attributes["id"] = &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Computed:     true,
+		ExactlyOneOf: []string{"id", "domain"},
+	}
+	attributes["domain"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Description:  "The domain of the site to look up, as an alternative to `id`.",
+		Optional:     true,
+		Computed:     true,
+		ExactlyOneOf: []string{"id", "domain"},
+	}
+
 	return &schema.Resource{
 		ReadContext: dataSourceSiteRead,
-		Schema: map[string]*schema.Schema{
-			"id": &schema.Schema{
-				Type:     schema.TypeInt,
-				Required: true,
-			},
-			"name": &schema.Schema{
-				Type:     schema.TypeString,
-				Required: true,
-			},
-			"username": &schema.Schema{
-				Type:     schema.TypeString,
-				Required: true,
-			},
-			"directory": &schema.Schema{
-				Type:     schema.TypeString,
-				Required: true,
-			},
-			"status": &schema.Schema{
-				Type:     schema.TypeString,
-				Computed: true,
-			},
+		Schema:      attributes,
+	}
+}
+
+// siteAttributes returns the computed attributes shared by the site data sources.
+func siteAttributes() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"username": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"directory": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"wildcards": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"status": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"repository": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"repository_provider": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"repository_branch": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"repository_status": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"deployment_status": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"quick_deploy": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"project_type": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"created_at": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"network": {
+			Type:        schema.TypeList,
+			Description: "The IDs of the servers that the site is able to connect to.",
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
 		},
 	}
 }
@@ -42,28 +105,72 @@ func dataSourceSiteRead(ctx context.Context, d *schema.ResourceData, m interface
 	var diags diag.Diagnostics
 
 	serverID := d.Get("server_id").(string)
-	siteID := strconv.Itoa(d.Get("id").(int))
-
-	site, err := c.GetSite(serverID, siteID)
 
-	if err != nil {
-		return diag.FromErr(err)
+	var site *lf.Site
+	if domain, ok := d.GetOk("domain"); ok && d.Get("id").(int) == 0 {
+		var err diag.Diagnostics
+		site, err = findSiteByDomain(ctx, c, serverID, domain.(string))
+		if err != nil {
+			return err
+		}
+	} else {
+		var err error
+		site, err = c.GetSite(ctx, serverID, strconv.Itoa(d.Get("id").(int)))
+		if err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
-	if err := d.Set("Name", site.Name); err != nil {
-		return diag.FromErr(err)
-	}
-	if err := d.Set("Username", site.Username); err != nil {
-		return diag.FromErr(err)
+	log.Printf("[INFO] [LARAVELFORGE:dataSourceSiteRead] Site: %#v", site)
+
+	d.SetId(strconv.Itoa(site.ID))
+	d.Set("id", site.ID)
+	d.Set("domain", site.Name)
+	for key, value := range flattenSite(site) {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
 	}
-	if err := d.Set("Directory", site.Directory); err != nil {
-		return diag.FromErr(err)
+
+	return diags
+}
+
+func findSiteByDomain(ctx context.Context, c *lf.Client, serverId string, domain string) (*lf.Site, diag.Diagnostics) {
+	sites, err := c.ListSites(ctx, serverId)
+	if err != nil {
+		return nil, err
 	}
-	if err := d.Set("Status", site.Status); err != nil {
-		return diag.FromErr(err)
+
+	for _, site := range sites {
+		if site.Name == domain {
+			return &site, nil
+		}
 	}
 
-	d.SetId(siteID)
+	return nil, diag.Errorf("No site found with domain %q on server %s.", domain, serverId)
+}
+
+// flattenSite maps a site onto the attributes returned by siteAttributes.
+func flattenSite(site *lf.Site) map[string]interface{} {
+	var network []string
+	for _, serverId := range site.Network {
+		network = append(network, strconv.Itoa(serverId))
+	}
 
-	return diags
+	return map[string]interface{}{
+		"name":                site.Name,
+		"username":            site.Username,
+		"directory":           site.Directory,
+		"wildcards":           site.Wildcards,
+		"status":              site.Status,
+		"repository":          site.Repository,
+		"repository_provider": site.RepositoryProvider,
+		"repository_branch":   site.RepositoryBranch,
+		"repository_status":   site.RepositoryStatus,
+		"deployment_status":   site.DeploymentStatus,
+		"quick_deploy":        site.QuickDeploy,
+		"project_type":        site.ProjectType,
+		"created_at":          site.CreatedAt,
+		"network":             network,
+	}
 }
@@ -0,0 +1,102 @@
+package laravelforge
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"log"
+	"regexp"
+	lf "tonning/terraform-provider-laravelforge/client"
+)
+
+func dataSourceSites() *schema.Resource {
+	site := siteAttributes()
+	site["id"] = &schema.Schema{
+		Type:     schema.TypeInt,
+		Computed: true,
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceSitesRead,
+		Schema: map[string]*schema.Schema{
+			"server_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"domain_regex": {
+				Type:         schema.TypeString,
+				Description:  "Only list sites whose domain matches this regular expression.",
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			"project_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"repository": {
+				Type:        schema.TypeString,
+				Description: "Only list sites with this repository installed, i.e. `laravel/laravel`.",
+				Optional:    true,
+			},
+			"sites": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: site,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceSitesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*lf.Client)
+
+	var diags diag.Diagnostics
+
+	serverId := d.Get("server_id").(string)
+
+	sites, err := c.ListSites(ctx, serverId)
+	log.Printf("[INFO] [LARAVELFORGE:dataSourceSitesRead] Sites: %#v", sites)
+	if err != nil {
+		return err
+	}
+
+	var domainRegex *regexp.Regexp
+	if v, ok := d.GetOk("domain_regex"); ok {
+		domainRegex = regexp.MustCompile(v.(string))
+	}
+
+	projectType := d.Get("project_type").(string)
+	repository := d.Get("repository").(string)
+
+	var items []interface{}
+	for i := range sites {
+		site := &sites[i]
+
+		if domainRegex != nil && !domainRegex.MatchString(site.Name) {
+			continue
+		}
+
+		if projectType != "" && site.ProjectType != projectType {
+			continue
+		}
+
+		if repository != "" && site.Repository != repository {
+			continue
+		}
+
+		item := flattenSite(site)
+		item["id"] = site.ID
+		items = append(items, item)
+	}
+
+	if err := d.Set("sites", items); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(serverId)
+
+	return diags
+}
@@ -0,0 +1,52 @@
+package laravelforge
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"strings"
+)
+
+// importServerResource imports a resource that lives on a server, using an
+// ID of the form "server_id/resource_id".
+func importServerResource(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("unexpected format of ID (%s), expected server_id/resource_id", d.Id())
+	}
+
+	d.Set("server_id", parts[0])
+	d.SetId(parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// importSiteResource imports a resource that lives on a site, using an ID of
+// the form "server_id/site_id/resource_id".
+func importSiteResource(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return nil, fmt.Errorf("unexpected format of ID (%s), expected server_id/site_id/resource_id", d.Id())
+	}
+
+	d.Set("server_id", parts[0])
+	d.Set("site_id", parts[1])
+	d.SetId(parts[2])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// importSiteSetting imports a resource that manages a single setting of a
+// site, using an ID of the form "server_id/site_id".
+func importSiteSetting(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("unexpected format of ID (%s), expected server_id/site_id", d.Id())
+	}
+
+	d.Set("server_id", parts[0])
+	d.Set("site_id", parts[1])
+	d.SetId(parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -2,9 +2,12 @@ package laravelforge
 
 import (
 	"context"
+	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"log"
+	"time"
 	"tonning/terraform-provider-laravelforge/client"
 )
 
@@ -17,19 +20,82 @@ func Provider() *schema.Provider {
 				Required:    true,
 				DefaultFunc: schema.EnvDefaultFunc("LARAVELFORGE_TOKEN", nil),
 			},
+			"api_url": {
+				Type:         schema.TypeString,
+				Description:  "The URL of the Laravel Forge API. Can also be set with the `LARAVELFORGE_API_URL` environment variable.",
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("LARAVELFORGE_API_URL", client.HostURL),
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+			"request_timeout": {
+				Type:         schema.TypeInt,
+				Description:  "The number of seconds to wait for a response from the API. Can also be set with the `LARAVELFORGE_REQUEST_TIMEOUT` environment variable.",
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("LARAVELFORGE_REQUEST_TIMEOUT", 10),
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"proxy_url": {
+				Type:         schema.TypeString,
+				Description:  "The URL of a proxy to send API requests through. Can also be set with the `LARAVELFORGE_PROXY_URL` environment variable.",
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("LARAVELFORGE_PROXY_URL", nil),
+				ValidateFunc: validation.IsURLWithScheme([]string{"http", "https", "socks5"}),
+			},
+			"max_retries": {
+				Type:         schema.TypeInt,
+				Description:  "The number of times a rate limited or failed request is retried. Can also be set with the `LARAVELFORGE_MAX_RETRIES` environment variable.",
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("LARAVELFORGE_MAX_RETRIES", client.DefaultMaxRetries),
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"retry_max_wait": {
+				Type:         schema.TypeInt,
+				Description:  "The maximum number of seconds to wait before retrying a request. Can also be set with the `LARAVELFORGE_RETRY_MAX_WAIT` environment variable.",
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("LARAVELFORGE_RETRY_MAX_WAIT", int(client.DefaultRetryMaxWait/time.Second)),
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"requests_per_minute": {
+				Type:         schema.TypeInt,
+				Description:  "The maximum number of requests to send to the API per minute, shared by all resources. Set to `0` to disable the limit. Can also be set with the `LARAVELFORGE_REQUESTS_PER_MINUTE` environment variable.",
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("LARAVELFORGE_REQUESTS_PER_MINUTE", client.DefaultRequestsPerMinute),
+				ValidateFunc: validation.IntAtLeast(0),
+			},
 		},
 		ResourcesMap: map[string]*schema.Resource{
-			"laravelforge_server":         resourceServer(),
-			"laravelforge_site":           resourceSite(),
-			"laravelforge_key":            resourceKey(),
-			"laravelforge_sslcertificate": resourceSslCertificate(),
-			"laravelforge_scheduledjob":   resourceScheduledJob(),
-			"laravelforge_daemon":         resourceDaemon(),
-			"laravelforge_redirectrule":   resourceRedirectRule(),
+			"laravelforge_server":               resourceServer(),
+			"laravelforge_site":                 resourceSite(),
+			"laravelforge_key":                  resourceKey(),
+			"laravelforge_sslcertificate":       resourceSslCertificate(),
+			"laravelforge_scheduledjob":         resourceScheduledJob(),
+			"laravelforge_daemon":               resourceDaemon(),
+			"laravelforge_redirectrule":         resourceRedirectRule(),
+			"laravelforge_database":             resourceDatabase(),
+			"laravelforge_database_user":        resourceDatabaseUser(),
+			"laravelforge_firewall_rule":        resourceFirewallRule(),
+			"laravelforge_worker":               resourceWorker(),
+			"laravelforge_deployment_script":    resourceDeploymentScript(),
+			"laravelforge_site_environment":     resourceSiteEnvironment(),
+			"laravelforge_deployment":           resourceDeployment(),
+			"laravelforge_nginx_config":         resourceNginxConfig(),
+			"laravelforge_nginx_template":       resourceNginxTemplate(),
+			"laravelforge_webhook":              resourceWebhook(),
+			"laravelforge_security_rule":        resourceSecurityRule(),
+			"laravelforge_monitor":              resourceMonitor(),
+			"laravelforge_recipe":               resourceRecipe(),
+			"laravelforge_recipe_run":           resourceRecipeRun(),
+			"laravelforge_backup_configuration": resourceBackupConfiguration(),
+			"laravelforge_php_version":          resourcePhpVersion(),
+			"laravelforge_service_action":       resourceServiceAction(),
 		},
 		DataSourcesMap: map[string]*schema.Resource{
-			"laravelforge_site":   dataSourceSite(),
-			"laravelforge_server": dataSourceServer(),
+			"laravelforge_site":     dataSourceSite(),
+			"laravelforge_sites":    dataSourceSites(),
+			"laravelforge_server":   dataSourceServer(),
+			"laravelforge_servers":  dataSourceServers(),
+			"laravelforge_monitors": dataSourceMonitors(),
+			"laravelforge_backups":  dataSourceBackups(),
 		},
 		ConfigureContextFunc: providerConfigure,
 	}
@@ -37,11 +103,12 @@ func Provider() *schema.Provider {
 
 func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 	token := d.Get("token").(string)
+	apiUrl := d.Get("api_url").(string)
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
-	c, err := client.NewClient(nil, &token)
+	c, err := client.NewClient(&apiUrl, &token)
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -51,5 +118,20 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}
 		return nil, diags
 	}
 
+	c.SetRequestTimeout(time.Duration(d.Get("request_timeout").(int)) * time.Second)
+	c.SetRetryPolicy(d.Get("max_retries").(int), time.Duration(d.Get("retry_max_wait").(int))*time.Second)
+	c.SetRateLimit(d.Get("requests_per_minute").(int))
+
+	if proxyUrl := d.Get("proxy_url").(string); proxyUrl != "" {
+		if err := c.SetProxyURL(proxyUrl); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Unable to create Laravel Forge client",
+				Detail:   fmt.Sprintf("Invalid proxy URL %q: %s", proxyUrl, err),
+			})
+			return nil, diags
+		}
+	}
+
 	return c, diags
 }
@@ -0,0 +1,275 @@
+package laravelforge
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"log"
+	"strconv"
+	lf "tonning/terraform-provider-laravelforge/client"
+)
+
+func resourceBackupConfiguration() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBackupConfigurationCreate,
+		ReadContext:   resourceBackupConfigurationRead,
+		UpdateContext: resourceBackupConfigurationUpdate,
+		DeleteContext: resourceBackupConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importServerResource,
+		},
+		Schema: map[string]*schema.Schema{
+			"server_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"storage_provider": {
+				Type:        schema.TypeString,
+				Description: "Where to store the backups. Use `custom` for S3 compatible storage.",
+				Required:    true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"s3",
+					"spaces",
+					"custom",
+				}, false),
+			},
+			"endpoint": {
+				Type:        schema.TypeString,
+				Description: "The endpoint of the S3 compatible storage. Required for the `custom` storage provider.",
+				Optional:    true,
+			},
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"access_key": {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+			"secret_key": {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+			"directory": {
+				Type:        schema.TypeString,
+				Description: "The directory in the bucket to store the backups in.",
+				Optional:    true,
+			},
+			"email": {
+				Type:        schema.TypeString,
+				Description: "The email address to notify when a backup fails.",
+				Optional:    true,
+			},
+			"frequency": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"hourly",
+					"daily",
+					"weekly",
+					"custom",
+				}, false),
+			},
+			"time": {
+				Type:        schema.TypeString,
+				Description: "The time of day to run `daily` and `weekly` backups, i.e. `12:30`.",
+				Optional:    true,
+			},
+			"day": {
+				Type:         schema.TypeInt,
+				Description:  "The day of the week to run `weekly` backups, from 0 (Sunday) to 6 (Saturday).",
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(0, 6),
+			},
+			"cron": {
+				Type:        schema.TypeString,
+				Description: "The cron expression to run `custom` backups on.",
+				Optional:    true,
+			},
+			"retention": {
+				Type:         schema.TypeInt,
+				Description:  "The number of backups to keep.",
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"databases": {
+				Type:        schema.TypeSet,
+				Description: "The IDs of the databases to back up.",
+				Required:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+			"last_backup_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func backupConfigurationRequest(d *schema.ResourceData) (*lf.BackupConfigurationRequest, diag.Diagnostics) {
+	frequency := lf.BackupFrequency{
+		Type: d.Get("frequency").(string),
+	}
+
+	switch frequency.Type {
+	case "daily", "weekly":
+		frequency.Time = d.Get("time").(string)
+		if frequency.Time == "" {
+			return nil, diag.Errorf("\"time\" is required for %s backups.", frequency.Type)
+		}
+		if frequency.Type == "weekly" {
+			day := d.Get("day").(int)
+			frequency.Day = &day
+		}
+	case "custom":
+		frequency.Custom = d.Get("cron").(string)
+		if frequency.Custom == "" {
+			return nil, diag.Errorf("\"cron\" is required for custom backups.")
+		}
+	}
+
+	provider := d.Get("storage_provider").(string)
+	endpoint := d.Get("endpoint").(string)
+	if provider == "custom" && endpoint == "" {
+		return nil, diag.Errorf("\"endpoint\" is required for the custom storage provider.")
+	}
+
+	return &lf.BackupConfigurationRequest{
+		Provider: provider,
+		Credentials: lf.BackupCredentials{
+			Endpoint:  endpoint,
+			Region:    d.Get("region").(string),
+			Bucket:    d.Get("bucket").(string),
+			AccessKey: d.Get("access_key").(string),
+			SecretKey: d.Get("secret_key").(string),
+		},
+		Frequency: frequency,
+		Directory: d.Get("directory").(string),
+		Email:     d.Get("email").(string),
+		Retention: d.Get("retention").(int),
+		Databases: expandDatabaseIds(d.Get("databases").(*schema.Set)),
+	}, nil
+}
+
+func resourceBackupConfigurationCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*lf.Client)
+
+	var diags diag.Diagnostics
+
+	log.Printf("[DEBUG] Backup Configuration creation")
+	opts, err := backupConfigurationRequest(d)
+	if err != nil {
+		return err
+	}
+
+	serverId := d.Get("server_id").(string)
+
+	backupConfiguration, err := client.CreateBackupConfiguration(ctx, serverId, opts)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] [LARAVELFORGE] Backup Configuration response: %#v", backupConfiguration)
+	d.SetId(strconv.Itoa(backupConfiguration.Id))
+	log.Printf("[INFO] [LARAVELFORGE] Backup Configuration ID: %s", strconv.Itoa(backupConfiguration.Id))
+
+	resourceBackupConfigurationRead(ctx, d, m)
+
+	return diags
+}
+
+func resourceBackupConfigurationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] [LARAVELFORGE:resourceBackupConfigurationRead] Start")
+	c := m.(*lf.Client)
+
+	var diags diag.Diagnostics
+
+	serverId := d.Get("server_id").(string)
+	backupConfigurationId := d.Id()
+
+	backupConfiguration, err := c.GetBackupConfiguration(ctx, serverId, backupConfigurationId)
+	log.Printf("[INFO] [LARAVELFORGE:resourceBackupConfigurationRead] ID: %s Backup Configuration: %#v", backupConfigurationId, backupConfiguration)
+	if err != nil {
+		if lf.IsNotFound(err) {
+			d.SetId("")
+			return diags
+		}
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.Itoa(backupConfiguration.Id))
+
+	var databases []int
+	for _, database := range backupConfiguration.Databases {
+		databases = append(databases, database.Id)
+	}
+
+	// Forge only returns the schedule of daily and weekly backups, so hourly and custom backups can't be told apart.
+	frequency := d.Get("frequency").(string)
+	switch {
+	case backupConfiguration.DayOfWeek != nil:
+		frequency = "weekly"
+		d.Set("day", *backupConfiguration.DayOfWeek)
+		d.Set("time", backupConfiguration.Time)
+	case backupConfiguration.Time != "":
+		frequency = "daily"
+		d.Set("time", backupConfiguration.Time)
+	case frequency == "daily" || frequency == "weekly":
+		frequency = "hourly"
+	}
+
+	d.Set("storage_provider", backupConfiguration.Provider)
+	d.Set("frequency", frequency)
+	d.Set("databases", databases)
+	d.Set("last_backup_time", backupConfiguration.LastBackupTime)
+
+	log.Printf("[INFO] [LARAVELFORGE:resourceBackupConfigurationRead] End")
+
+	return diags
+}
+
+func resourceBackupConfigurationUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*lf.Client)
+	backupConfigurationId := d.Id()
+	serverId := d.Get("server_id").(string)
+
+	backupConfigurationUpdates, err := backupConfigurationRequest(d)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] [LARAVELFORGE:resourceBackupConfigurationUpdate] ID: %s", backupConfigurationId)
+
+	_, err = client.UpdateBackupConfiguration(ctx, serverId, backupConfigurationId, *backupConfigurationUpdates)
+	if err != nil {
+		return err
+	}
+
+	return resourceBackupConfigurationRead(ctx, d, m)
+}
+
+func resourceBackupConfigurationDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*lf.Client)
+
+	var diags diag.Diagnostics
+
+	backupConfigurationId := d.Id()
+
+	err := c.DeleteBackupConfiguration(ctx, d.Get("server_id").(string), backupConfigurationId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
@@ -95,14 +95,14 @@ func resourceDaemonCreate(ctx context.Context, d *schema.ResourceData, m interfa
 
 	serverId := d.Get("server_id").(string)
 
-	daemon, err := client.CreateDaemon(serverId, opts)
+	daemon, err := client.CreateDaemon(ctx, serverId, opts)
 	daemonId := daemon.Id
 
 	attempts := 0
 
 	// Wait for status to be other than "installing".
 	for shouldCheck := true; shouldCheck; shouldCheck = daemon.Status == "installing" {
-		daemon, err := client.GetDaemon(serverId, strconv.Itoa(daemonId))
+		daemon, err := client.GetDaemon(ctx, serverId, strconv.Itoa(daemonId))
 		log.Printf("[INFO] [LARAVELFORGE] Daemon waiting: %#v", daemon)
 
 		if err != nil {
@@ -118,7 +118,9 @@ func resourceDaemonCreate(ctx context.Context, d *schema.ResourceData, m interfa
 			return diag.Errorf("Unable to add daemon. Timeout.")
 		}
 
-		time.Sleep(time.Second * 5)
+		if err := lf.SleepContext(ctx, time.Second*5); err != nil {
+			return diag.FromErr(err)
+		}
 		attempts++
 	}
 
@@ -144,11 +146,14 @@ func resourceDaemonRead(ctx context.Context, d *schema.ResourceData, m interface
 	serverId := d.Get("server_id").(string)
 	daemonId := d.Id()
 
-	daemon, err := c.GetDaemon(serverId, daemonId)
+	daemon, err := c.GetDaemon(ctx, serverId, daemonId)
 	log.Printf("[INFO] [LARAVELFORGE:resourceDaemonRead] ID: %s Daemon: %#v", daemonId, daemon)
 	if err != nil {
-		d.SetId("")
-		return diags
+		if lf.IsNotFound(err) {
+			d.SetId("")
+			return diags
+		}
+		return diag.FromErr(err)
 	}
 
 	d.SetId(strconv.Itoa(daemon.Id))
@@ -175,7 +180,7 @@ func resourceDaemonDelete(ctx context.Context, d *schema.ResourceData, m interfa
 
 	daemonId := d.Id()
 
-	err := c.DeleteDaemon(d.Get("server_id").(string), daemonId)
+	err := c.DeleteDaemon(ctx, d.Get("server_id").(string), daemonId)
 	if err != nil {
 		return diag.FromErr(err)
 	}
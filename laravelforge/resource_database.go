@@ -0,0 +1,163 @@
+package laravelforge
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"log"
+	"strconv"
+	"time"
+	lf "tonning/terraform-provider-laravelforge/client"
+)
+
+func resourceDatabase() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDatabaseCreate,
+		ReadContext:   resourceDatabaseRead,
+		DeleteContext: resourceDatabaseDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importServerResource,
+		},
+		Schema: map[string]*schema.Schema{
+			"server_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"user": {
+				Type:         schema.TypeString,
+				Description:  "The name of a database user to create along with the database.",
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"password"},
+			},
+			"password": {
+				Type:         schema.TypeString,
+				Description:  "The password of the database user created along with the database.",
+				Optional:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				RequiredWith: []string{"user"},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDatabaseCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*lf.Client)
+
+	var diags diag.Diagnostics
+
+	log.Printf("[DEBUG] Database creation")
+	opts := &lf.CreateDatabaseRequest{
+		Name:     d.Get("name").(string),
+		User:     d.Get("user").(string),
+		Password: d.Get("password").(string),
+	}
+
+	serverId := d.Get("server_id").(string)
+
+	database, err := client.CreateDatabase(ctx, serverId, opts)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.Itoa(database.Id))
+	log.Printf("[INFO] [LARAVELFORGE] Database ID: %s", d.Id())
+
+	attempts := 0
+
+	// Wait for status to be other than "installing".
+	for shouldCheck := true; shouldCheck; shouldCheck = database.Status == "installing" {
+		var getErr error
+		database, getErr = client.GetDatabase(ctx, serverId, d.Id())
+		log.Printf("[INFO] [LARAVELFORGE] Database waiting: %#v", database)
+
+		if getErr != nil {
+			return diag.FromErr(getErr)
+		}
+
+		if database.Status == "installed" {
+			break
+		}
+
+		if database.Status != "installing" {
+			return diag.Errorf("Unable to add database. Status: %s.", database.Status)
+		}
+
+		if attempts > 60 {
+			return diag.Errorf("Unable to add database. Timeout.")
+		}
+
+		if err := lf.SleepContext(ctx, time.Second*10); err != nil {
+			return diag.FromErr(err)
+		}
+		attempts++
+	}
+
+	log.Printf("[INFO] [LARAVELFORGE] Database response: %#v", database)
+
+	resourceDatabaseRead(ctx, d, m)
+
+	return diags
+}
+
+func resourceDatabaseRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] [LARAVELFORGE:resourceDatabaseRead] Start")
+	c := m.(*lf.Client)
+
+	var diags diag.Diagnostics
+
+	serverId := d.Get("server_id").(string)
+	databaseId := d.Id()
+
+	database, err := c.GetDatabase(ctx, serverId, databaseId)
+	log.Printf("[INFO] [LARAVELFORGE:resourceDatabaseRead] ID: %s Database: %#v", databaseId, database)
+	if err != nil {
+		if lf.IsNotFound(err) {
+			d.SetId("")
+			return diags
+		}
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.Itoa(database.Id))
+
+	d.Set("name", database.Name)
+	d.Set("status", database.Status)
+	d.Set("created_at", database.CreatedAt)
+
+	log.Printf("[INFO] [LARAVELFORGE:resourceDatabaseRead] End")
+
+	return diags
+}
+
+func resourceDatabaseDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*lf.Client)
+
+	var diags diag.Diagnostics
+
+	databaseId := d.Id()
+
+	err := c.DeleteDatabase(ctx, d.Get("server_id").(string), databaseId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
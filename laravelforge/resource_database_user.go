@@ -0,0 +1,192 @@
+package laravelforge
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"log"
+	"strconv"
+	"time"
+	lf "tonning/terraform-provider-laravelforge/client"
+)
+
+func resourceDatabaseUser() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDatabaseUserCreate,
+		ReadContext:   resourceDatabaseUserRead,
+		UpdateContext: resourceDatabaseUserUpdate,
+		DeleteContext: resourceDatabaseUserDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importServerResource,
+		},
+		Schema: map[string]*schema.Schema{
+			"server_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"password": {
+				Type:      schema.TypeString,
+				Required:  true,
+				ForceNew:  true,
+				Sensitive: true,
+			},
+			"databases": {
+				Type:        schema.TypeSet,
+				Description: "The IDs of the databases the user should have access to.",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDatabaseUserCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*lf.Client)
+
+	var diags diag.Diagnostics
+
+	log.Printf("[DEBUG] Database User creation")
+	opts := &lf.CreateDatabaseUserRequest{
+		Name:      d.Get("name").(string),
+		Password:  d.Get("password").(string),
+		Databases: expandDatabaseIds(d.Get("databases").(*schema.Set)),
+	}
+
+	serverId := d.Get("server_id").(string)
+
+	user, err := client.CreateDatabaseUser(ctx, serverId, opts)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.Itoa(user.Id))
+	log.Printf("[INFO] [LARAVELFORGE] Database User ID: %s", d.Id())
+
+	attempts := 0
+
+	// Wait for status to be other than "installing".
+	for shouldCheck := true; shouldCheck; shouldCheck = user.Status == "installing" {
+		var getErr error
+		user, getErr = client.GetDatabaseUser(ctx, serverId, d.Id())
+		log.Printf("[INFO] [LARAVELFORGE] Database User waiting: %#v", user)
+
+		if getErr != nil {
+			return diag.FromErr(getErr)
+		}
+
+		if user.Status == "installed" {
+			break
+		}
+
+		if user.Status != "installing" {
+			return diag.Errorf("Unable to add database user. Status: %s.", user.Status)
+		}
+
+		if attempts > 60 {
+			return diag.Errorf("Unable to add database user. Timeout.")
+		}
+
+		if err := lf.SleepContext(ctx, time.Second*10); err != nil {
+			return diag.FromErr(err)
+		}
+		attempts++
+	}
+
+	log.Printf("[INFO] [LARAVELFORGE] Database User response: %#v", user)
+
+	resourceDatabaseUserRead(ctx, d, m)
+
+	return diags
+}
+
+func resourceDatabaseUserRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] [LARAVELFORGE:resourceDatabaseUserRead] Start")
+	c := m.(*lf.Client)
+
+	var diags diag.Diagnostics
+
+	serverId := d.Get("server_id").(string)
+	userId := d.Id()
+
+	user, err := c.GetDatabaseUser(ctx, serverId, userId)
+	log.Printf("[INFO] [LARAVELFORGE:resourceDatabaseUserRead] ID: %s User: %#v", userId, user)
+	if err != nil {
+		if lf.IsNotFound(err) {
+			d.SetId("")
+			return diags
+		}
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.Itoa(user.Id))
+
+	d.Set("name", user.Name)
+	d.Set("databases", user.Databases)
+	d.Set("status", user.Status)
+	d.Set("created_at", user.CreatedAt)
+
+	log.Printf("[INFO] [LARAVELFORGE:resourceDatabaseUserRead] End")
+
+	return diags
+}
+
+func resourceDatabaseUserUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*lf.Client)
+	userId := d.Id()
+	serverId := d.Get("server_id").(string)
+
+	if d.HasChange("databases") {
+		userUpdates := lf.UpdateDatabaseUserRequest{
+			Databases: expandDatabaseIds(d.Get("databases").(*schema.Set)),
+		}
+
+		log.Printf("[INFO] [LARAVELFORGE:resourceDatabaseUserUpdate] User updates: %#v", userUpdates)
+
+		_, err := client.UpdateDatabaseUser(ctx, serverId, userId, userUpdates)
+		if err != nil {
+			return err
+		}
+	}
+
+	return resourceDatabaseUserRead(ctx, d, m)
+}
+
+func resourceDatabaseUserDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*lf.Client)
+
+	var diags diag.Diagnostics
+
+	userId := d.Id()
+
+	err := c.DeleteDatabaseUser(ctx, d.Get("server_id").(string), userId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+func expandDatabaseIds(set *schema.Set) []int {
+	databases := make([]int, 0, set.Len())
+	for _, v := range set.List() {
+		databases = append(databases, v.(int))
+	}
+
+	return databases
+}
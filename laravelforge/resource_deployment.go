@@ -0,0 +1,170 @@
+package laravelforge
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"log"
+	"strconv"
+	"time"
+	lf "tonning/terraform-provider-laravelforge/client"
+)
+
+func resourceDeployment() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDeploymentCreate,
+		ReadContext:   resourceDeploymentRead,
+		DeleteContext: resourceDeploymentDelete,
+		Schema: map[string]*schema.Schema{
+			"server_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"site_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Description: "Arbitrary map of values that, when changed, will trigger a new deployment.",
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"commit_hash": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"commit_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"started_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ended_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDeploymentCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*lf.Client)
+
+	var diags diag.Diagnostics
+
+	serverId := d.Get("server_id").(string)
+	siteId := d.Get("site_id").(string)
+
+	// Remember the latest deployment, so the deployment triggered below can't be confused with it.
+	previous, err := latestDeployment(ctx, client, serverId, siteId)
+	if err != nil {
+		return err
+	}
+
+	previousId := 0
+	if previous != nil {
+		previousId = previous.Id
+	}
+
+	log.Printf("[DEBUG] Deployment of site %s, previous deployment: %d", siteId, previousId)
+
+	_, err = client.DeploySite(ctx, serverId, siteId)
+	if err != nil {
+		return err
+	}
+
+	var deployment *lf.Deployment
+	attempts := 0
+
+	// Wait for a deployment newer than the previous one to have finished.
+	for {
+		deployment, err = latestDeployment(ctx, client, serverId, siteId)
+		log.Printf("[INFO] [LARAVELFORGE] Deployment waiting: %#v", deployment)
+
+		if err != nil {
+			return err
+		}
+
+		if deployment != nil && deployment.Id > previousId && (deployment.Status == "finished" || deployment.Status == "failed") {
+			break
+		}
+
+		if attempts > 120 {
+			return diag.Errorf("Unable to deploy site. Timeout.")
+		}
+
+		if err := lf.SleepContext(ctx, time.Second*5); err != nil {
+			return diag.FromErr(err)
+		}
+		attempts++
+	}
+
+	log.Printf("[INFO] [LARAVELFORGE] Deployment response: %#v", deployment)
+
+	if deployment.Status == "failed" {
+		output, err := client.GetDeploymentOutput(ctx, serverId, siteId, strconv.Itoa(deployment.Id))
+		if err != nil {
+			return err
+		}
+
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Deployment failed",
+				Detail:   output,
+			},
+		}
+	}
+
+	d.SetId(strconv.Itoa(deployment.Id))
+
+	d.Set("status", deployment.Status)
+	d.Set("commit_hash", deployment.CommitHash)
+	d.Set("commit_message", deployment.CommitMessage)
+	d.Set("started_at", deployment.StartedAt)
+	d.Set("ended_at", deployment.EndedAt)
+
+	return diags
+}
+
+func resourceDeploymentRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// A deployment can't change once it has finished, so the state is kept as is.
+	return diags
+}
+
+func resourceDeploymentDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	d.SetId("")
+
+	return diags
+}
+
+// latestDeployment returns the most recent deployment of a site, or nil when the site was never deployed.
+func latestDeployment(ctx context.Context, client *lf.Client, serverId string, siteId string) (*lf.Deployment, diag.Diagnostics) {
+	deployments, err := client.ListDeployments(ctx, serverId, siteId)
+	if err != nil {
+		return nil, err
+	}
+
+	var latest *lf.Deployment
+	for i := range deployments {
+		if latest == nil || deployments[i].Id > latest.Id {
+			latest = &deployments[i]
+		}
+	}
+
+	return latest, nil
+}
@@ -0,0 +1,116 @@
+package laravelforge
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"log"
+	"strings"
+	lf "tonning/terraform-provider-laravelforge/client"
+)
+
+func resourceDeploymentScript() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDeploymentScriptCreate,
+		ReadContext:   resourceDeploymentScriptRead,
+		UpdateContext: resourceDeploymentScriptUpdate,
+		DeleteContext: resourceDeploymentScriptDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importSiteSetting,
+		},
+		Schema: map[string]*schema.Schema{
+			"server_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"site_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"content": {
+				Type:     schema.TypeString,
+				Required: true,
+				StateFunc: func(val any) string {
+					return normalizeLineEndings(val.(string))
+				},
+			},
+			"auto_source": {
+				Type:        schema.TypeBool,
+				Description: "Whether to make the site's environment variables available to the deployment script.",
+				Optional:    true,
+				Default:     false,
+			},
+		},
+	}
+}
+
+func resourceDeploymentScriptCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] Deployment Script creation")
+
+	d.SetId(d.Get("site_id").(string))
+
+	return resourceDeploymentScriptUpdate(ctx, d, m)
+}
+
+func resourceDeploymentScriptRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] [LARAVELFORGE:resourceDeploymentScriptRead] Start")
+	c := m.(*lf.Client)
+
+	var diags diag.Diagnostics
+
+	serverId := d.Get("server_id").(string)
+	siteId := d.Get("site_id").(string)
+
+	script, err := c.GetDeploymentScript(ctx, serverId, siteId)
+	log.Printf("[INFO] [LARAVELFORGE:resourceDeploymentScriptRead] Site ID: %s Script: %#v", siteId, script)
+	if err != nil {
+		if lf.IsNotFound(err) {
+			d.SetId("")
+			return diags
+		}
+		return diag.FromErr(err)
+	}
+
+	d.Set("content", normalizeLineEndings(script))
+
+	log.Printf("[INFO] [LARAVELFORGE:resourceDeploymentScriptRead] End")
+
+	return diags
+}
+
+func resourceDeploymentScriptUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*lf.Client)
+	serverId := d.Get("server_id").(string)
+	siteId := d.Get("site_id").(string)
+
+	scriptUpdate := lf.DeploymentScriptUpdateRequest{
+		Content:    normalizeLineEndings(d.Get("content").(string)),
+		AutoSource: d.Get("auto_source").(bool),
+	}
+
+	err := client.UpdateDeploymentScript(ctx, serverId, siteId, scriptUpdate)
+	if err != nil {
+		return err
+	}
+
+	return resourceDeploymentScriptRead(ctx, d, m)
+}
+
+func resourceDeploymentScriptDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Forge always keeps a deployment script for a site, so the script is left in place.
+	d.SetId("")
+
+	return diags
+}
+
+// normalizeLineEndings converts Windows line endings and strips trailing
+// newlines, so scripts authored on different platforms produce the same plan.
+func normalizeLineEndings(content string) string {
+	content = strings.ReplaceAll(content, "\r\n", "\n")
+
+	return strings.TrimRight(content, "\n")
+}
@@ -0,0 +1,182 @@
+package laravelforge
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"log"
+	"regexp"
+	"strconv"
+	"time"
+	lf "tonning/terraform-provider-laravelforge/client"
+)
+
+func resourceFirewallRule() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceFirewallRuleCreate,
+		ReadContext:   resourceFirewallRuleRead,
+		DeleteContext: resourceFirewallRuleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importServerResource,
+		},
+		Schema: map[string]*schema.Schema{
+			"server_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"port": {
+				Type:        schema.TypeString,
+				Description: "A single port, i.e. `8080`, or a port range, i.e. `8000:8010`.",
+				Required:    true,
+				ForceNew:    true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile(`^\d+(:\d+)?$`),
+					"must be a port number or a port range separated by a colon",
+				),
+			},
+			"ip_address": {
+				Type:        schema.TypeString,
+				Description: "The IP address the rule applies to. Leave empty to apply the rule to any IP address.",
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "allow",
+				ValidateFunc: validation.StringInSlice([]string{
+					"allow",
+					"deny",
+				}, false),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceFirewallRuleCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*lf.Client)
+
+	var diags diag.Diagnostics
+
+	log.Printf("[DEBUG] Firewall Rule creation")
+	opts := &lf.CreateFirewallRuleRequest{
+		Name:      d.Get("name").(string),
+		Port:      d.Get("port").(string),
+		IpAddress: d.Get("ip_address").(string),
+		Type:      d.Get("type").(string),
+	}
+
+	log.Printf("[DEBUG] Firewall Rule configuration: %#v", opts)
+
+	serverId := d.Get("server_id").(string)
+
+	rule, err := client.CreateFirewallRule(ctx, serverId, opts)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.Itoa(rule.Id))
+	log.Printf("[INFO] [LARAVELFORGE] Firewall Rule ID: %s", d.Id())
+
+	attempts := 0
+
+	// Wait for status to be other than "installing".
+	for shouldCheck := true; shouldCheck; shouldCheck = rule.Status == "installing" {
+		var getErr error
+		rule, getErr = client.GetFirewallRule(ctx, serverId, d.Id())
+		log.Printf("[INFO] [LARAVELFORGE] Firewall Rule waiting: %#v", rule)
+
+		if getErr != nil {
+			return diag.FromErr(getErr)
+		}
+
+		if rule.Status == "installed" {
+			break
+		}
+
+		if rule.Status != "installing" {
+			return diag.Errorf("Unable to add firewall rule. Status: %s.", rule.Status)
+		}
+
+		if attempts > 10 {
+			return diag.Errorf("Unable to add firewall rule. Timeout.")
+		}
+
+		if err := lf.SleepContext(ctx, time.Second*5); err != nil {
+			return diag.FromErr(err)
+		}
+		attempts++
+	}
+
+	log.Printf("[INFO] [LARAVELFORGE] Firewall Rule response: %#v", rule)
+
+	resourceFirewallRuleRead(ctx, d, m)
+
+	return diags
+}
+
+func resourceFirewallRuleRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] [LARAVELFORGE:resourceFirewallRuleRead] Start")
+	c := m.(*lf.Client)
+
+	var diags diag.Diagnostics
+
+	serverId := d.Get("server_id").(string)
+	ruleId := d.Id()
+
+	rule, err := c.GetFirewallRule(ctx, serverId, ruleId)
+	log.Printf("[INFO] [LARAVELFORGE:resourceFirewallRuleRead] ID: %s Rule: %#v", ruleId, rule)
+	if err != nil {
+		if lf.IsNotFound(err) {
+			d.SetId("")
+			return diags
+		}
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.Itoa(rule.Id))
+
+	d.Set("name", rule.Name)
+	d.Set("port", string(rule.Port))
+	d.Set("ip_address", rule.IpAddress)
+	d.Set("type", rule.Type)
+	d.Set("status", rule.Status)
+	d.Set("created_at", rule.CreatedAt)
+
+	log.Printf("[INFO] [LARAVELFORGE:resourceFirewallRuleRead] End")
+
+	return diags
+}
+
+func resourceFirewallRuleDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*lf.Client)
+
+	var diags diag.Diagnostics
+
+	ruleId := d.Id()
+
+	err := c.DeleteFirewallRule(ctx, d.Get("server_id").(string), ruleId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
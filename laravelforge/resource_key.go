@@ -78,7 +78,7 @@ func resourceKeyCreate(ctx context.Context, d *schema.ResourceData, m interface{
 
 	serverId := d.Get("server_id").(string)
 
-	key, err := client.CreateKey(serverId, opts, true)
+	key, err := client.CreateKey(ctx, serverId, opts, true)
 
 	if err != nil {
 		return err
@@ -102,11 +102,14 @@ func resourceKeyRead(ctx context.Context, d *schema.ResourceData, m interface{})
 	serverId := d.Get("server_id").(string)
 	keyId := d.Id()
 
-	key, err := c.GetKey(serverId, keyId)
+	key, err := c.GetKey(ctx, serverId, keyId)
 	log.Printf("[INFO] [LARAVELFORGE:resourceKeyRead] ID: %s Key: %#v", keyId, key)
 	if err != nil {
-		d.SetId("")
-		return diags
+		if lf.IsNotFound(err) {
+			d.SetId("")
+			return diags
+		}
+		return diag.FromErr(err)
 	}
 
 	d.SetId(strconv.Itoa(key.Id))
@@ -127,7 +130,7 @@ func resourceKeyDelete(ctx context.Context, d *schema.ResourceData, m interface{
 
 	keyId := d.Id()
 
-	err := c.DeleteKey(d.Get("server_id").(string), keyId)
+	err := c.DeleteKey(ctx, d.Get("server_id").(string), keyId)
 	if err != nil {
 		return diag.FromErr(err)
 	}
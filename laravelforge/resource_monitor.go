@@ -0,0 +1,199 @@
+package laravelforge
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"log"
+	"strconv"
+	"time"
+	lf "tonning/terraform-provider-laravelforge/client"
+)
+
+func resourceMonitor() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceMonitorCreate,
+		ReadContext:   resourceMonitorRead,
+		DeleteContext: resourceMonitorDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importServerResource,
+		},
+		Schema: map[string]*schema.Schema{
+			"server_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"disk",
+					"used_memory",
+					"cpu_load",
+				}, false),
+			},
+			"operator": {
+				Type:        schema.TypeString,
+				Description: "Whether to alert when the value is greater than or equal to (`gte`) or less than or equal to (`lte`) the threshold.",
+				Required:    true,
+				ForceNew:    true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"gte",
+					"lte",
+				}, false),
+			},
+			"threshold": {
+				Type:         schema.TypeInt,
+				Description:  "The threshold in percent.",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(0, 100),
+			},
+			"minutes": {
+				Type:         schema.TypeInt,
+				Description:  "The number of minutes the threshold must be crossed before alerting.",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"notify": {
+				Type:        schema.TypeString,
+				Description: "The email address to notify.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state_changed_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceMonitorCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*lf.Client)
+
+	var diags diag.Diagnostics
+
+	log.Printf("[DEBUG] Monitor creation")
+	opts := &lf.CreateMonitorRequest{
+		Type:      d.Get("type").(string),
+		Operator:  d.Get("operator").(string),
+		Threshold: d.Get("threshold").(int),
+		Minutes:   d.Get("minutes").(int),
+		Notify:    d.Get("notify").(string),
+	}
+
+	log.Printf("[DEBUG] Monitor configuration: %#v", opts)
+
+	serverId := d.Get("server_id").(string)
+
+	monitor, err := client.CreateMonitor(ctx, serverId, opts)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.Itoa(monitor.Id))
+	log.Printf("[INFO] [LARAVELFORGE] Monitor ID: %s", d.Id())
+
+	attempts := 0
+
+	// Wait for status to be other than "installing".
+	for shouldCheck := true; shouldCheck; shouldCheck = monitor.Status == "installing" {
+		var getErr error
+		monitor, getErr = client.GetMonitor(ctx, serverId, d.Id())
+		log.Printf("[INFO] [LARAVELFORGE] Monitor waiting: %#v", monitor)
+
+		if getErr != nil {
+			return diag.FromErr(getErr)
+		}
+
+		if monitor.Status == "installed" {
+			break
+		}
+
+		if monitor.Status != "installing" {
+			return diag.Errorf("Unable to add monitor. Status: %s.", monitor.Status)
+		}
+
+		if attempts > 10 {
+			return diag.Errorf("Unable to add monitor. Timeout.")
+		}
+
+		if err := lf.SleepContext(ctx, time.Second*5); err != nil {
+			return diag.FromErr(err)
+		}
+		attempts++
+	}
+
+	log.Printf("[INFO] [LARAVELFORGE] Monitor response: %#v", monitor)
+
+	resourceMonitorRead(ctx, d, m)
+
+	return diags
+}
+
+func resourceMonitorRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] [LARAVELFORGE:resourceMonitorRead] Start")
+	c := m.(*lf.Client)
+
+	var diags diag.Diagnostics
+
+	serverId := d.Get("server_id").(string)
+	monitorId := d.Id()
+
+	monitor, err := c.GetMonitor(ctx, serverId, monitorId)
+	log.Printf("[INFO] [LARAVELFORGE:resourceMonitorRead] ID: %s Monitor: %#v", monitorId, monitor)
+	if err != nil {
+		if lf.IsNotFound(err) {
+			d.SetId("")
+			return diags
+		}
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.Itoa(monitor.Id))
+
+	d.Set("type", monitor.Type)
+	d.Set("operator", monitor.Operator)
+	d.Set("threshold", monitor.Threshold)
+	d.Set("minutes", monitor.Minutes)
+	if monitor.Notify != "" {
+		d.Set("notify", monitor.Notify)
+	}
+	d.Set("state", monitor.State)
+	d.Set("state_changed_at", monitor.StateChangedAt)
+	d.Set("status", monitor.Status)
+
+	log.Printf("[INFO] [LARAVELFORGE:resourceMonitorRead] End")
+
+	return diags
+}
+
+func resourceMonitorDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*lf.Client)
+
+	var diags diag.Diagnostics
+
+	monitorId := d.Id()
+
+	err := c.DeleteMonitor(ctx, d.Get("server_id").(string), monitorId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
@@ -0,0 +1,202 @@
+package laravelforge
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"log"
+	"regexp"
+	"strconv"
+	"strings"
+	lf "tonning/terraform-provider-laravelforge/client"
+)
+
+func resourceNginxConfig() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNginxConfigCreate,
+		ReadContext:   resourceNginxConfigRead,
+		UpdateContext: resourceNginxConfigUpdate,
+		DeleteContext: resourceNginxConfigDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importSiteSetting,
+		},
+		Schema: map[string]*schema.Schema{
+			"server_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"site_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"content": {
+				Type:     schema.TypeString,
+				Required: true,
+				StateFunc: func(val any) string {
+					return normalizeNginxConfig(val.(string))
+				},
+			},
+			"restore_on_destroy": {
+				Type:        schema.TypeBool,
+				Description: "Whether to replace the configuration with one rendered from the server's default Nginx template when the resource is destroyed, instead of leaving the custom configuration in place.",
+				Optional:    true,
+				Default:     false,
+			},
+		},
+	}
+}
+
+func resourceNginxConfigCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] Nginx Config creation")
+
+	d.SetId(d.Get("site_id").(string))
+
+	return resourceNginxConfigUpdate(ctx, d, m)
+}
+
+func resourceNginxConfigRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] [LARAVELFORGE:resourceNginxConfigRead] Start")
+	c := m.(*lf.Client)
+
+	var diags diag.Diagnostics
+
+	serverId := d.Get("server_id").(string)
+	siteId := d.Get("site_id").(string)
+
+	content, err := c.GetNginxConfig(ctx, serverId, siteId)
+	if err != nil {
+		if lf.IsNotFound(err) {
+			d.SetId("")
+			return diags
+		}
+		return diag.FromErr(err)
+	}
+
+	d.Set("content", normalizeNginxConfig(content))
+
+	log.Printf("[INFO] [LARAVELFORGE:resourceNginxConfigRead] End")
+
+	return diags
+}
+
+func resourceNginxConfigUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*lf.Client)
+	serverId := d.Get("server_id").(string)
+	siteId := d.Get("site_id").(string)
+
+	if d.HasChange("content") {
+		configUpdate := lf.NginxConfigUpdateRequest{
+			Content: d.Get("content").(string),
+		}
+
+		err := client.UpdateNginxConfig(ctx, serverId, siteId, configUpdate)
+		if err != nil {
+			return err
+		}
+	}
+
+	return resourceNginxConfigRead(ctx, d, m)
+}
+
+func resourceNginxConfigDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if d.Get("restore_on_destroy").(bool) == true {
+		c := m.(*lf.Client)
+		serverId := d.Get("server_id").(string)
+		siteId := d.Get("site_id").(string)
+
+		content, err := defaultNginxConfig(ctx, c, serverId, siteId)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		configUpdate := lf.NginxConfigUpdateRequest{
+			Content: content,
+		}
+
+		updateErr := c.UpdateNginxConfig(ctx, serverId, siteId, configUpdate)
+		if updateErr != nil {
+			return updateErr
+		}
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+// defaultNginxConfig renders the server's default Nginx template for a site,
+// the way Forge does when the site is created.
+func defaultNginxConfig(ctx context.Context, c *lf.Client, serverId string, siteId string) (string, error) {
+	template, err := c.GetDefaultNginxTemplate(ctx, serverId)
+	if err != nil {
+		return "", err
+	}
+
+	server, err, _ := c.GetServer(ctx, serverId)
+	if err != nil {
+		return "", err
+	}
+
+	site, err := c.GetSite(ctx, serverId, siteId)
+	if err != nil {
+		return "", err
+	}
+
+	return renderNginxTemplate(template.Content, server, site), nil
+}
+
+var nginxTemplateVariableRegexp = regexp.MustCompile(`{{\s*([A-Z0-9_]+)\s*}}`)
+
+// renderNginxTemplate replaces the variables Forge supports in Nginx templates
+// with the values of the given site and server. Unknown variables are left as they are.
+func renderNginxTemplate(content string, server *lf.Server, site *lf.Site) string {
+	rootPath := fmt.Sprintf("/home/%s/%s", site.Username, site.Name)
+
+	proxyPass := "unix:/var/run/php/php-fpm.sock"
+	if version := strings.TrimPrefix(site.PhpVersion, "php"); len(version) > 1 {
+		proxyPass = fmt.Sprintf("unix:/var/run/php/php%s.%s-fpm.sock", version[:1], version[1:])
+	}
+
+	variables := map[string]string{
+		"DIRECTORY":         site.Directory,
+		"DOMAINS":           strings.Join(append([]string{site.Name}, site.Aliases...), " "),
+		"PATH":              rootPath + site.Directory,
+		"PORT":              "80",
+		"PORT_V6":           "[::]:80",
+		"PROXY_PASS":        proxyPass,
+		"ROOT_PATH":         rootPath,
+		"SERVER_PUBLIC_IP":  server.IpAddress,
+		"SERVER_PRIVATE_IP": server.PrivateIpAddress,
+		"SITE":              site.Name,
+		"SITE_ID":           strconv.Itoa(site.ID),
+		"USER":              site.Username,
+	}
+
+	return nginxTemplateVariableRegexp.ReplaceAllStringFunc(content, func(match string) string {
+		if value, ok := variables[nginxTemplateVariableRegexp.FindStringSubmatch(match)[1]]; ok {
+			return value
+		}
+
+		return match
+	})
+}
+
+var blankLinesRegexp = regexp.MustCompile(`\n{3,}`)
+
+// normalizeNginxConfig strips trailing whitespace from every line and
+// collapses runs of blank lines, which Nginx ignores anyway.
+func normalizeNginxConfig(content string) string {
+	lines := strings.Split(normalizeLineEndings(content), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " \t")
+	}
+
+	content = blankLinesRegexp.ReplaceAllString(strings.Join(lines, "\n"), "\n\n")
+
+	return strings.Trim(content, "\n")
+}
@@ -0,0 +1,133 @@
+package laravelforge
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"log"
+	"strconv"
+	lf "tonning/terraform-provider-laravelforge/client"
+)
+
+func resourceNginxTemplate() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNginxTemplateCreate,
+		ReadContext:   resourceNginxTemplateRead,
+		UpdateContext: resourceNginxTemplateUpdate,
+		DeleteContext: resourceNginxTemplateDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importServerResource,
+		},
+		Schema: map[string]*schema.Schema{
+			"server_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"content": {
+				Type:     schema.TypeString,
+				Required: true,
+				StateFunc: func(val any) string {
+					return normalizeNginxConfig(val.(string))
+				},
+			},
+		},
+	}
+}
+
+func resourceNginxTemplateCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*lf.Client)
+
+	var diags diag.Diagnostics
+
+	log.Printf("[DEBUG] Nginx Template creation")
+	opts := &lf.NginxTemplateRequest{
+		Name:    d.Get("name").(string),
+		Content: d.Get("content").(string),
+	}
+
+	serverId := d.Get("server_id").(string)
+
+	template, err := client.CreateNginxTemplate(ctx, serverId, opts)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] [LARAVELFORGE] Nginx Template response: %#v", template)
+	d.SetId(strconv.Itoa(template.Id))
+	log.Printf("[INFO] [LARAVELFORGE] Nginx Template ID: %s", strconv.Itoa(template.Id))
+
+	resourceNginxTemplateRead(ctx, d, m)
+
+	return diags
+}
+
+func resourceNginxTemplateRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] [LARAVELFORGE:resourceNginxTemplateRead] Start")
+	c := m.(*lf.Client)
+
+	var diags diag.Diagnostics
+
+	serverId := d.Get("server_id").(string)
+	templateId := d.Id()
+
+	template, err := c.GetNginxTemplate(ctx, serverId, templateId)
+	log.Printf("[INFO] [LARAVELFORGE:resourceNginxTemplateRead] ID: %s Template: %#v", templateId, template)
+	if err != nil {
+		if lf.IsNotFound(err) {
+			d.SetId("")
+			return diags
+		}
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.Itoa(template.Id))
+
+	d.Set("name", template.Name)
+	d.Set("content", normalizeNginxConfig(template.Content))
+
+	log.Printf("[INFO] [LARAVELFORGE:resourceNginxTemplateRead] End")
+
+	return diags
+}
+
+func resourceNginxTemplateUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*lf.Client)
+	templateId := d.Id()
+	serverId := d.Get("server_id").(string)
+
+	if d.HasChanges("name", "content") {
+		templateUpdates := lf.NginxTemplateRequest{
+			Name:    d.Get("name").(string),
+			Content: d.Get("content").(string),
+		}
+
+		_, err := client.UpdateNginxTemplate(ctx, serverId, templateId, templateUpdates)
+		if err != nil {
+			return err
+		}
+	}
+
+	return resourceNginxTemplateRead(ctx, d, m)
+}
+
+func resourceNginxTemplateDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*lf.Client)
+
+	var diags diag.Diagnostics
+
+	templateId := d.Id()
+
+	err := c.DeleteNginxTemplate(ctx, d.Get("server_id").(string), templateId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
@@ -0,0 +1,211 @@
+package laravelforge
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+	lf "tonning/terraform-provider-laravelforge/client"
+)
+
+func resourcePhpVersion() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourcePhpVersionCreate,
+		ReadContext:   resourcePhpVersionRead,
+		UpdateContext: resourcePhpVersionUpdate,
+		DeleteContext: resourcePhpVersionDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importServerResource,
+		},
+		Schema: map[string]*schema.Schema{
+			"server_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"version": {
+				Type:        schema.TypeString,
+				Description: "The PHP version to install, i.e. `php81`.",
+				Required:    true,
+				ForceNew:    true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile(`^php\d+$`),
+					"must be a PHP version such as php81",
+				),
+			},
+			"update_triggers": {
+				Type:        schema.TypeMap,
+				Description: "A map of arbitrary values that, when changed, will install the latest patch release of the PHP version.",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"displayable_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"binary_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"used_as_default": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"used_on_cli": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourcePhpVersionCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*lf.Client)
+
+	var diags diag.Diagnostics
+
+	serverId := d.Get("server_id").(string)
+	version := d.Get("version").(string)
+
+	log.Printf("[DEBUG] PHP Version installation: %s", version)
+
+	err := client.InstallPhpVersion(ctx, serverId, version)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(version)
+
+	err = waitForPhpVersion(ctx, client, serverId, version, false)
+	if err != nil {
+		return err
+	}
+
+	resourcePhpVersionRead(ctx, d, m)
+
+	return diags
+}
+
+func resourcePhpVersionRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] [LARAVELFORGE:resourcePhpVersionRead] Start")
+	c := m.(*lf.Client)
+
+	var diags diag.Diagnostics
+
+	serverId := d.Get("server_id").(string)
+	version := d.Id()
+
+	phpVersion, err := c.GetPhpVersion(ctx, serverId, version)
+	log.Printf("[INFO] [LARAVELFORGE:resourcePhpVersionRead] ID: %s PHP Version: %#v", version, phpVersion)
+	if err != nil {
+		if lf.IsNotFound(err) {
+			d.SetId("")
+			return diags
+		}
+		return diag.FromErr(err)
+	}
+
+	if phpVersion == nil {
+		d.SetId("")
+		return diags
+	}
+
+	d.Set("version", phpVersion.Version)
+	d.Set("displayable_version", phpVersion.DisplayableVersion)
+	d.Set("binary_name", phpVersion.BinaryName)
+	d.Set("status", phpVersion.Status)
+	d.Set("used_as_default", phpVersion.UsedAsDefault)
+	d.Set("used_on_cli", phpVersion.UsedOnCli)
+
+	log.Printf("[INFO] [LARAVELFORGE:resourcePhpVersionRead] End")
+
+	return diags
+}
+
+func resourcePhpVersionUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*lf.Client)
+	serverId := d.Get("server_id").(string)
+	version := d.Id()
+
+	if d.HasChange("update_triggers") {
+		log.Printf("[INFO] [LARAVELFORGE:resourcePhpVersionUpdate] Updating %s", version)
+
+		err := client.UpdatePhpVersion(ctx, serverId, version)
+		if err != nil {
+			return err
+		}
+
+		err = waitForPhpVersion(ctx, client, serverId, version, true)
+		if err != nil {
+			return err
+		}
+	}
+
+	return resourcePhpVersionRead(ctx, d, m)
+}
+
+func resourcePhpVersionDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*lf.Client)
+
+	var diags diag.Diagnostics
+
+	err := c.DeletePhpVersion(ctx, d.Get("server_id").(string), d.Id())
+	if err != nil {
+		return err
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+// phpUpdateStartAttempts is how many polls an updated PHP version may stay "installed" before the
+// update is assumed to have finished between two polls.
+const phpUpdateStartAttempts = 3
+
+// waitForPhpVersion waits until Forge has finished installing or updating a PHP version. An updated
+// version keeps its "installed" status until Forge picks up the update, so when updating the status
+// has to change before "installed" means the update is done, unless it never changes within the
+// first few polls.
+func waitForPhpVersion(ctx context.Context, client *lf.Client, serverId string, version string, updating bool) diag.Diagnostics {
+	started := !updating
+	attempts := 0
+
+	for {
+		phpVersion, err := client.GetPhpVersion(ctx, serverId, version)
+		log.Printf("[INFO] [LARAVELFORGE] PHP Version waiting - Attempts: %#v PHP Version: %#v", attempts, phpVersion)
+
+		if err != nil && !lf.IsNotFound(err) {
+			return diag.FromErr(err)
+		}
+
+		if phpVersion != nil {
+			if strings.Contains(phpVersion.Status, "fail") {
+				return diag.Errorf("Unable to install PHP version %s. Status: %s.", version, phpVersion.Status)
+			}
+
+			if phpVersion.Status != "installed" {
+				started = true
+			} else if started || attempts >= phpUpdateStartAttempts {
+				return nil
+			}
+		}
+
+		if attempts > 30 {
+			return diag.Errorf("Unable to install PHP version %s. Timeout.", version)
+		}
+
+		if err := lf.SleepContext(ctx, time.Second*10); err != nil {
+			return diag.FromErr(err)
+		}
+		attempts++
+	}
+}
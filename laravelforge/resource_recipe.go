@@ -0,0 +1,141 @@
+package laravelforge
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"log"
+	"strconv"
+	lf "tonning/terraform-provider-laravelforge/client"
+)
+
+func resourceRecipe() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceRecipeCreate,
+		ReadContext:   resourceRecipeRead,
+		UpdateContext: resourceRecipeUpdate,
+		DeleteContext: resourceRecipeDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"user": {
+				Type:        schema.TypeString,
+				Description: "The user the script runs as.",
+				Optional:    true,
+				Default:     "root",
+			},
+			"script": {
+				Type:     schema.TypeString,
+				Required: true,
+				StateFunc: func(val any) string {
+					return normalizeLineEndings(val.(string))
+				},
+			},
+			"key": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceRecipeCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*lf.Client)
+
+	var diags diag.Diagnostics
+
+	log.Printf("[DEBUG] Recipe creation")
+	opts := &lf.RecipeRequest{
+		Name:   d.Get("name").(string),
+		User:   d.Get("user").(string),
+		Script: normalizeLineEndings(d.Get("script").(string)),
+	}
+
+	recipe, err := client.CreateRecipe(ctx, opts)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] [LARAVELFORGE] Recipe response: %#v", recipe)
+	d.SetId(strconv.Itoa(recipe.Id))
+	log.Printf("[INFO] [LARAVELFORGE] Recipe ID: %s", strconv.Itoa(recipe.Id))
+
+	resourceRecipeRead(ctx, d, m)
+
+	return diags
+}
+
+func resourceRecipeRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] [LARAVELFORGE:resourceRecipeRead] Start")
+	c := m.(*lf.Client)
+
+	var diags diag.Diagnostics
+
+	recipeId := d.Id()
+
+	recipe, err := c.GetRecipe(ctx, recipeId)
+	log.Printf("[INFO] [LARAVELFORGE:resourceRecipeRead] ID: %s Recipe: %#v", recipeId, recipe)
+	if err != nil {
+		if lf.IsNotFound(err) {
+			d.SetId("")
+			return diags
+		}
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.Itoa(recipe.Id))
+
+	d.Set("name", recipe.Name)
+	d.Set("user", recipe.User)
+	d.Set("script", normalizeLineEndings(recipe.Script))
+	d.Set("key", recipe.Key)
+	d.Set("created_at", recipe.CreatedAt)
+
+	log.Printf("[INFO] [LARAVELFORGE:resourceRecipeRead] End")
+
+	return diags
+}
+
+func resourceRecipeUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*lf.Client)
+	recipeId := d.Id()
+
+	if d.HasChanges("name", "user", "script") {
+		recipeUpdates := lf.RecipeRequest{
+			Name:   d.Get("name").(string),
+			User:   d.Get("user").(string),
+			Script: normalizeLineEndings(d.Get("script").(string)),
+		}
+
+		_, err := client.UpdateRecipe(ctx, recipeId, recipeUpdates)
+		if err != nil {
+			return err
+		}
+	}
+
+	return resourceRecipeRead(ctx, d, m)
+}
+
+func resourceRecipeDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*lf.Client)
+
+	var diags diag.Diagnostics
+
+	err := c.DeleteRecipe(ctx, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
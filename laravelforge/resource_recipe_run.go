@@ -0,0 +1,81 @@
+package laravelforge
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"log"
+	lf "tonning/terraform-provider-laravelforge/client"
+)
+
+func resourceRecipeRun() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceRecipeRunCreate,
+		ReadContext:   resourceRecipeRunRead,
+		DeleteContext: resourceRecipeRunDelete,
+		Schema: map[string]*schema.Schema{
+			"recipe_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"server_ids": {
+				Type:        schema.TypeSet,
+				Description: "The IDs of the servers to run the recipe on.",
+				Required:    true,
+				ForceNew:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Description: "Arbitrary map of values that, when changed, will run the recipe again, i.e. the recipe's `script`.",
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceRecipeRunCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*lf.Client)
+
+	var diags diag.Diagnostics
+
+	recipeId := d.Get("recipe_id").(string)
+
+	var servers []int
+	for _, v := range d.Get("server_ids").(*schema.Set).List() {
+		servers = append(servers, v.(int))
+	}
+
+	log.Printf("[DEBUG] Recipe %s run on servers: %#v", recipeId, servers)
+
+	err := client.RunRecipe(ctx, recipeId, lf.RunRecipeRequest{
+		Servers: servers,
+	})
+	if err != nil {
+		return err
+	}
+
+	d.SetId(resource.UniqueId())
+
+	return diags
+}
+
+func resourceRecipeRunRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// A recipe run can't change once it has been started, so the state is kept as is.
+	return diags
+}
+
+func resourceRecipeRunDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	d.SetId("")
+
+	return diags
+}
@@ -89,14 +89,14 @@ func resourceRedirectRuleCreate(ctx context.Context, d *schema.ResourceData, m i
 	serverId := d.Get("server_id").(string)
 	siteId := d.Get("site_id").(string)
 
-	redirectRule, err := client.CreateRedirectRule(serverId, siteId, opts)
+	redirectRule, err := client.CreateRedirectRule(ctx, serverId, siteId, opts)
 	//redirectRuleId := redirectRule.Id
 
 	//attempts := 0
 	//
 	//// Wait for status to be other than "installing".
 	//for shouldCheck := true; shouldCheck; shouldCheck = redirectRule.Status == "installing" {
-	//	redirectRule, err = client.GetRedirectRule(serverId, strconv.Itoa(redirectRuleId))
+	//	redirectRule, err = client.GetRedirectRule(ctx, serverId, strconv.Itoa(redirectRuleId))
 	//	log.Printf("[INFO] [LARAVELFORGE] Redirect Rule waiting: %#v", redirectRule)
 	//
 	//	if err != nil {
@@ -139,11 +139,14 @@ func resourceRedirectRuleRead(ctx context.Context, d *schema.ResourceData, m int
 	siteId := d.Get("site_id").(string)
 	redirectRuleId := d.Id()
 
-	redirectRule, err := c.GetRedirectRule(serverId, siteId, redirectRuleId)
+	redirectRule, err := c.GetRedirectRule(ctx, serverId, siteId, redirectRuleId)
 	log.Printf("[INFO] [LARAVELFORGE:resourceRedirectRuleRead] ID: %s Redirect Rule: %#v", redirectRuleId, redirectRule)
 	if err != nil {
-		d.SetId("")
-		return diags
+		if lf.IsNotFound(err) {
+			d.SetId("")
+			return diags
+		}
+		return diag.FromErr(err)
 	}
 
 	d.SetId(strconv.Itoa(redirectRule.Id))
@@ -167,7 +170,7 @@ func resourceRedirectRuleDelete(ctx context.Context, d *schema.ResourceData, m i
 	siteId := d.Get("site_id").(string)
 	redirectRuleId := d.Id()
 
-	err := c.DeleteRedirectRule(serverId, siteId, redirectRuleId)
+	err := c.DeleteRedirectRule(ctx, serverId, siteId, redirectRuleId)
 	if err != nil {
 		return diag.FromErr(err)
 	}
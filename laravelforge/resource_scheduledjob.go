@@ -134,19 +134,20 @@ func resourceScheduledJobCreate(ctx context.Context, d *schema.ResourceData, m i
 
 	serverId := d.Get("server_id").(string)
 
-	job, err := client.CreateScheduledJob(serverId, opts)
+	job, err := client.CreateScheduledJob(ctx, serverId, opts)
 	jobId := job.Id
 
 	attempts := 0
 
 	// Wait for status to be other than "installing".
 	for shouldCheck := true; shouldCheck; shouldCheck = job.Status == "installing" {
-		job, err = client.GetScheduledJob(serverId, strconv.Itoa(jobId))
+		var getErr error
+		job, getErr = client.GetScheduledJob(ctx, serverId, strconv.Itoa(jobId))
 		log.Printf("[INFO] [LARAVELFORGE] Scheduled Job waiting: %#v", job)
 
-		if err != nil {
+		if getErr != nil {
 			d.SetId("")
-			return err
+			return diag.FromErr(getErr)
 		}
 
 		if job.Status == "installed" {
@@ -157,7 +158,9 @@ func resourceScheduledJobCreate(ctx context.Context, d *schema.ResourceData, m i
 			return diag.Errorf("Unable to add scheduled job. Timeout.")
 		}
 
-		time.Sleep(time.Second * 10)
+		if err := lf.SleepContext(ctx, time.Second*10); err != nil {
+			return diag.FromErr(err)
+		}
 		attempts++
 	}
 
@@ -183,11 +186,14 @@ func resourceScheduledJobRead(ctx context.Context, d *schema.ResourceData, m int
 	serverId := d.Get("server_id").(string)
 	jobId := d.Id()
 
-	job, err := c.GetScheduledJob(serverId, jobId)
+	job, err := c.GetScheduledJob(ctx, serverId, jobId)
 	log.Printf("[INFO] [LARAVELFORGE:resourceScheduledJobRead] ID: %s Job: %#v", jobId, job)
 	if err != nil {
-		d.SetId("")
-		return diags
+		if lf.IsNotFound(err) {
+			d.SetId("")
+			return diags
+		}
+		return diag.FromErr(err)
 	}
 
 	d.SetId(strconv.Itoa(job.Id))
@@ -211,7 +217,7 @@ func resourceScheduledJobDelete(ctx context.Context, d *schema.ResourceData, m i
 
 	jobId := d.Id()
 
-	err := c.DeleteScheduledJob(d.Get("server_id").(string), jobId)
+	err := c.DeleteScheduledJob(ctx, d.Get("server_id").(string), jobId)
 	if err != nil {
 		return diag.FromErr(err)
 	}
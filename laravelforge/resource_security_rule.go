@@ -0,0 +1,210 @@
+package laravelforge
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"log"
+	"strconv"
+	"time"
+	lf "tonning/terraform-provider-laravelforge/client"
+)
+
+func resourceSecurityRule() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSecurityRuleCreate,
+		ReadContext:   resourceSecurityRuleRead,
+		DeleteContext: resourceSecurityRuleDelete,
+		Schema: map[string]*schema.Schema{
+			"server_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"site_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"path": {
+				Type:        schema.TypeString,
+				Description: "The path to protect. Leave empty to protect the whole site.",
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"credentials": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"username": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"password": {
+							Type:      schema.TypeString,
+							Required:  true,
+							ForceNew:  true,
+							Sensitive: true,
+						},
+					},
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceSecurityRuleCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*lf.Client)
+
+	var diags diag.Diagnostics
+
+	log.Printf("[DEBUG] Security Rule creation")
+	opts := &lf.CreateSecurityRuleRequest{
+		Name: d.Get("name").(string),
+		Path: d.Get("path").(string),
+	}
+
+	for _, v := range d.Get("credentials").([]interface{}) {
+		credential := v.(map[string]interface{})
+		opts.Credentials = append(opts.Credentials, lf.SecurityRuleCredential{
+			Username: credential["username"].(string),
+			Password: credential["password"].(string),
+		})
+	}
+
+	serverId := d.Get("server_id").(string)
+	siteId := d.Get("site_id").(string)
+
+	rule, err := client.CreateSecurityRule(ctx, serverId, siteId, opts)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.Itoa(rule.Id))
+	log.Printf("[INFO] [LARAVELFORGE] Security Rule ID: %s", d.Id())
+
+	attempts := 0
+
+	// Wait for status to be other than "installing".
+	for shouldCheck := true; shouldCheck; shouldCheck = rule.Status == "installing" {
+		var getErr error
+		rule, getErr = client.GetSecurityRule(ctx, serverId, siteId, d.Id())
+		log.Printf("[INFO] [LARAVELFORGE] Security Rule waiting: %#v", rule)
+
+		if getErr != nil {
+			return diag.FromErr(getErr)
+		}
+
+		if rule.Status == "installed" {
+			break
+		}
+
+		if rule.Status != "installing" {
+			return diag.Errorf("Unable to add security rule. Status: %s.", rule.Status)
+		}
+
+		if attempts > 10 {
+			return diag.Errorf("Unable to add security rule. Timeout.")
+		}
+
+		if err := lf.SleepContext(ctx, time.Second*5); err != nil {
+			return diag.FromErr(err)
+		}
+		attempts++
+	}
+
+	log.Printf("[INFO] [LARAVELFORGE] Security Rule response: %#v", rule)
+
+	resourceSecurityRuleRead(ctx, d, m)
+
+	return diags
+}
+
+func resourceSecurityRuleRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] [LARAVELFORGE:resourceSecurityRuleRead] Start")
+	c := m.(*lf.Client)
+
+	var diags diag.Diagnostics
+
+	serverId := d.Get("server_id").(string)
+	siteId := d.Get("site_id").(string)
+	ruleId := d.Id()
+
+	rule, err := c.GetSecurityRule(ctx, serverId, siteId, ruleId)
+	log.Printf("[INFO] [LARAVELFORGE:resourceSecurityRuleRead] ID: %s Rule: %#v", ruleId, rule)
+	if err != nil {
+		if lf.IsNotFound(err) {
+			d.SetId("")
+			return diags
+		}
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.Itoa(rule.Id))
+
+	d.Set("name", rule.Name)
+	d.Set("path", rule.Path)
+	d.Set("status", rule.Status)
+	d.Set("created_at", rule.CreatedAt)
+
+	// Forge never returns passwords, so they are kept from the state.
+	passwords := map[string]interface{}{}
+	for _, v := range d.Get("credentials").([]interface{}) {
+		credential := v.(map[string]interface{})
+		passwords[credential["username"].(string)] = credential["password"]
+	}
+
+	var credentials []interface{}
+	for _, credential := range rule.Credentials {
+		password, ok := passwords[credential.Username]
+		if !ok {
+			password = ""
+		}
+
+		credentials = append(credentials, map[string]interface{}{
+			"username": credential.Username,
+			"password": password,
+		})
+	}
+	d.Set("credentials", credentials)
+
+	log.Printf("[INFO] [LARAVELFORGE:resourceSecurityRuleRead] End")
+
+	return diags
+}
+
+func resourceSecurityRuleDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*lf.Client)
+
+	var diags diag.Diagnostics
+
+	serverId := d.Get("server_id").(string)
+	siteId := d.Get("site_id").(string)
+	ruleId := d.Id()
+
+	err := c.DeleteSecurityRule(ctx, serverId, siteId, ruleId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
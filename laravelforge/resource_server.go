@@ -41,8 +41,15 @@ func resourceServer() *schema.Resource {
 				Required: true,
 			},
 			"php_version": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:        schema.TypeString,
+				Description: "The default PHP version for new sites. The version must be installed on the server.",
+				Required:    true,
+			},
+			"php_cli_version": {
+				Type:        schema.TypeString,
+				Description: "The PHP version used on the command line. The version must be installed on the server.",
+				Optional:    true,
+				Computed:    true,
 			},
 			"ip_address": {
 				Type:     schema.TypeString,
@@ -90,6 +97,39 @@ func resourceServer() *schema.Resource {
 				Computed:  true,
 				Sensitive: false,
 			},
+			"php_versions": {
+				Type:        schema.TypeList,
+				Description: "The PHP versions installed on the server.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"displayable_version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"binary_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"used_as_default": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"used_on_cli": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
 		},
 		CreateContext: resourceServerCreate,
 		ReadContext:   resourceServerRead,
@@ -120,7 +160,7 @@ func resourceServerCreate(ctx context.Context, d *schema.ResourceData, m interfa
 		PrivateIpAddress: d.Get("private_ip_address").(string),
 	}
 
-	server, err, _ := client.CreateServer(opts)
+	server, err, _ := client.CreateServer(ctx, opts)
 	if err != nil {
 		return diag.Errorf("Error: %s", err)
 	}
@@ -136,7 +176,7 @@ func resourceServerCreate(ctx context.Context, d *schema.ResourceData, m interfa
 
 	// Wait for status to be other than "installing".
 	for shouldCheck := true; shouldCheck; shouldCheck = server.Server.IsReady {
-		server, err, _ := client.GetServer(strconv.Itoa(serverId))
+		server, err, _ := client.GetServer(ctx, strconv.Itoa(serverId))
 		log.Printf("[INFO] [LARAVELFORGE:resourceSiteCreate] Waiting - Attempts: %#v Server: %#v", attempts, server)
 
 		if err != nil {
@@ -152,7 +192,9 @@ func resourceServerCreate(ctx context.Context, d *schema.ResourceData, m interfa
 			return diag.Errorf("Unable to create server. Too many attempts.")
 		}
 
-		time.Sleep(time.Second * 30)
+		if err := lf.SleepContext(ctx, time.Second*30); err != nil {
+			return diag.FromErr(err)
+		}
 		attempts++
 	}
 
@@ -168,7 +210,14 @@ func resourceServerCreate(ctx context.Context, d *schema.ResourceData, m interfa
 	d.Set("public_key", server.Server.LocalPublicKey)
 
 	if d.Get("opcache").(bool) == true {
-		err := client.EnableOpcache(strconv.Itoa(serverId))
+		err := client.EnableOpcache(ctx, strconv.Itoa(serverId))
+		if err != nil {
+			return err
+		}
+	}
+
+	if phpCliVersion := d.Get("php_cli_version").(string); phpCliVersion != "" && phpCliVersion != server.Server.PhpCliVersion {
+		err := client.SetPhpCliVersion(ctx, strconv.Itoa(serverId), phpCliVersion)
 		if err != nil {
 			return err
 		}
@@ -186,9 +235,9 @@ func resourceServerRead(ctx context.Context, d *schema.ResourceData, m interface
 
 	serverId := d.Id()
 
-	server, err, response := client.GetServer(serverId)
+	server, err, response := client.GetServer(ctx, serverId)
 	if err != nil {
-		if response.StatusCode == 404 {
+		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
 
 			return diags
@@ -201,10 +250,12 @@ func resourceServerRead(ctx context.Context, d *schema.ResourceData, m interface
 	d.Set("name", server.Name)
 	d.Set("type", server.Type)
 	d.Set("php_version", server.PhpVersion)
+	d.Set("php_cli_version", server.PhpCliVersion)
 	d.Set("ip_address", server.IpAddress)
 	d.Set("private_ip_address", server.PrivateIpAddress)
 	d.Set("is_ready", server.IsReady)
 	d.Set("public_key", server.LocalPublicKey)
+	d.Set("php_versions", flattenPhpVersions(server.PhpVersions))
 
 	log.Printf("[INFO] [LARAVELFORGE:resourceSiteRead] End")
 
@@ -225,18 +276,32 @@ func resourceServerUpdate(ctx context.Context, d *schema.ResourceData, m interfa
 
 	log.Printf("[INFO] [LARAVELFORGE:resourceServerUpdate] server updates: %#v", serverUpdates)
 
-	_, err, _ := client.UpdateServer(serverId, serverUpdates)
+	_, err, _ := client.UpdateServer(ctx, serverId, serverUpdates)
 	if err != nil {
 		return err
 	}
 
+	if d.HasChange("php_version") {
+		err := client.SetPhpSiteVersion(ctx, serverId, d.Get("php_version").(string))
+		if err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("php_cli_version") {
+		err := client.SetPhpCliVersion(ctx, serverId, d.Get("php_cli_version").(string))
+		if err != nil {
+			return err
+		}
+	}
+
 	if d.Get("opcache").(bool) == true {
-		err := client.EnableOpcache(serverId)
+		err := client.EnableOpcache(ctx, serverId)
 		if err != nil {
 			return err
 		}
 	} else {
-		err := client.DisableOpcache(serverId)
+		err := client.DisableOpcache(ctx, serverId)
 		if err != nil {
 			return err
 		}
@@ -252,9 +317,9 @@ func resourceServerDelete(ctx context.Context, d *schema.ResourceData, m interfa
 
 	serverId := d.Id()
 
-	err, res := c.DeleteServer(serverId)
+	err, res := c.DeleteServer(ctx, serverId)
 	if err != nil {
-		if res.StatusCode != 404 {
+		if res == nil || res.StatusCode != 404 {
 			return diag.FromErr(err)
 		}
 	}
@@ -263,3 +328,19 @@ func resourceServerDelete(ctx context.Context, d *schema.ResourceData, m interfa
 
 	return diags
 }
+
+func flattenPhpVersions(phpVersions []lf.PhpVersion) []interface{} {
+	var items []interface{}
+	for _, phpVersion := range phpVersions {
+		items = append(items, map[string]interface{}{
+			"version":             phpVersion.Version,
+			"displayable_version": phpVersion.DisplayableVersion,
+			"binary_name":         phpVersion.BinaryName,
+			"status":              phpVersion.Status,
+			"used_as_default":     phpVersion.UsedAsDefault,
+			"used_on_cli":         phpVersion.UsedOnCli,
+		})
+	}
+
+	return items
+}
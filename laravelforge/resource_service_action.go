@@ -0,0 +1,109 @@
+package laravelforge
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"log"
+	lf "tonning/terraform-provider-laravelforge/client"
+)
+
+func resourceServiceAction() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceServiceActionCreate,
+		ReadContext:   resourceServiceActionRead,
+		DeleteContext: resourceServiceActionDelete,
+		Schema: map[string]*schema.Schema{
+			"server_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"service": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"mysql",
+					"nginx",
+					"php",
+					"postgres",
+					"redis",
+				}, false),
+			},
+			"action": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "restart",
+				ValidateFunc: validation.StringInSlice([]string{
+					"restart",
+					"start",
+					"stop",
+				}, false),
+			},
+			"php_version": {
+				Type:        schema.TypeString,
+				Description: "The PHP-FPM version to act on, i.e. `php81`. Only used by the `php` service.",
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Description: "Arbitrary map of values that, when changed, will run the action again, i.e. the `content` of a `laravelforge_nginx_config`.",
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceServiceActionCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*lf.Client)
+
+	var diags diag.Diagnostics
+
+	serverId := d.Get("server_id").(string)
+	service := d.Get("service").(string)
+	action := d.Get("action").(string)
+	serviceAction := lf.ServiceActionRequest{
+		Version: d.Get("php_version").(string),
+	}
+
+	log.Printf("[DEBUG] Service action %s %s on server %s", action, service, serverId)
+
+	var err diag.Diagnostics
+	switch action {
+	case "start":
+		err = client.StartService(ctx, serverId, service, serviceAction)
+	case "stop":
+		err = client.StopService(ctx, serverId, service, serviceAction)
+	default:
+		err = client.RestartService(ctx, serverId, service, serviceAction)
+	}
+	if err != nil {
+		return err
+	}
+
+	d.SetId(resource.UniqueId())
+
+	return diags
+}
+
+func resourceServiceActionRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// A service action can't change once it has been run, so the state is kept as is.
+	return diags
+}
+
+func resourceServiceActionDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	d.SetId("")
+
+	return diags
+}
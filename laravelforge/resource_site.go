@@ -4,8 +4,10 @@ import (
 	"context"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"log"
 	"strconv"
+	"time"
 	lf "tonning/terraform-provider-laravelforge/client"
 )
 
@@ -40,6 +42,12 @@ func resourceSite() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"nginx_template_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the Nginx template to create the site with.",
+				Optional:    true,
+				ForceNew:    true,
+			},
 			"aliases": {
 				Type:        schema.TypeList,
 				Description: "A list of domain aliases.",
@@ -52,6 +60,53 @@ func resourceSite() *schema.Resource {
 				Optional:    true,
 				Default:     false,
 			},
+			"repository": {
+				Type:        schema.TypeList,
+				Description: "The Git repository installed on the site.",
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"provider": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"github",
+								"gitlab",
+								"bitbucket",
+								"custom",
+							}, false),
+						},
+						"repository": {
+							Type:        schema.TypeString,
+							Description: "The repository to install, i.e. `laravel/laravel`, or the Git URL for a custom provider.",
+							Required:    true,
+						},
+						"branch": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "main",
+						},
+						"composer": {
+							Type:        schema.TypeBool,
+							Description: "Whether to run `composer install` when the repository is installed.",
+							Optional:    true,
+							Default:     true,
+						},
+					},
+				},
+			},
+			"repository_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"quick_deploy": {
+				Type:         schema.TypeBool,
+				Description:  "Whether to deploy the site automatically when the repository branch is pushed to.",
+				Optional:     true,
+				Default:      false,
+				RequiredWith: []string{"repository"},
+			},
 		},
 	}
 }
@@ -82,11 +137,15 @@ func resourceSiteCreate(ctx context.Context, d *schema.ResourceData, m interface
 		opts.PhpVersion = v.(string)
 	}
 
+	if v, ok := d.GetOk("nginx_template_id"); ok {
+		opts.NginxTemplate = v.(int)
+	}
+
 	log.Printf("[DEBUG] Site create configuration: %#v", opts)
 
 	serverId := d.Get("server_id").(string)
 
-	site, err := client.CreateSite(serverId, opts)
+	site, err := client.CreateSite(ctx, serverId, opts)
 
 	if err != nil {
 		return err
@@ -96,6 +155,50 @@ func resourceSiteCreate(ctx context.Context, d *schema.ResourceData, m interface
 	d.SetId(strconv.Itoa(site.ID))
 	log.Printf("[INFO] [LARAVELFORGE] Site ID: %s", strconv.Itoa(site.ID))
 
+	if v, ok := d.GetOk("repository"); ok {
+		attempts := 0
+
+		// Wait for the site to be installed before a repository can be added.
+		for shouldCheck := true; shouldCheck; shouldCheck = site.Status == "installing" {
+			var getErr error
+			site, getErr = client.GetSite(ctx, serverId, d.Id())
+			log.Printf("[INFO] [LARAVELFORGE] Site waiting: %#v", site)
+
+			if getErr != nil {
+				return diag.FromErr(getErr)
+			}
+
+			if site.Status == "installed" {
+				break
+			}
+
+			if site.Status != "installing" {
+				return diag.Errorf("Unable to install repository. Site status: %s.", site.Status)
+			}
+
+			if attempts > 30 {
+				return diag.Errorf("Unable to install repository. Site not installed.")
+			}
+
+			if err := lf.SleepContext(ctx, time.Second*10); err != nil {
+				return diag.FromErr(err)
+			}
+			attempts++
+		}
+
+		err := installSiteRepository(ctx, client, serverId, d.Id(), v.([]interface{})[0].(map[string]interface{}))
+		if err != nil {
+			return err
+		}
+	}
+
+	if d.Get("quick_deploy").(bool) == true {
+		err := client.EnableQuickDeploy(ctx, serverId, d.Id())
+		if err != nil {
+			return err
+		}
+	}
+
 	resourceSiteRead(ctx, d, m)
 
 	return diags
@@ -110,11 +213,14 @@ func resourceSiteRead(ctx context.Context, d *schema.ResourceData, m interface{}
 	serverId := d.Get("server_id").(string)
 	siteId := d.Id()
 
-	site, err := c.GetSite(serverId, siteId)
+	site, err := c.GetSite(ctx, serverId, siteId)
 	log.Printf("[INFO] [LARAVELFORGE:resourceSiteRead] ID: %s Site: %#v", siteId, site)
 	if err != nil {
-		d.SetId("")
-		return diags
+		if lf.IsNotFound(err) {
+			d.SetId("")
+			return diags
+		}
+		return diag.FromErr(err)
 	}
 
 	d.SetId(strconv.Itoa(site.ID))
@@ -124,6 +230,26 @@ func resourceSiteRead(ctx context.Context, d *schema.ResourceData, m interface{}
 	d.Set("directory", site.Directory)
 	d.Set("status", site.Status)
 	d.Set("wildcards", site.Wildcards)
+	d.Set("repository_status", site.RepositoryStatus)
+	d.Set("quick_deploy", site.QuickDeploy)
+
+	if site.Repository != "" {
+		composer := true
+		if v, ok := d.GetOk("repository"); ok {
+			composer = v.([]interface{})[0].(map[string]interface{})["composer"].(bool)
+		}
+
+		d.Set("repository", []interface{}{
+			map[string]interface{}{
+				"provider":   site.RepositoryProvider,
+				"repository": site.Repository,
+				"branch":     site.RepositoryBranch,
+				"composer":   composer,
+			},
+		})
+	} else {
+		d.Set("repository", []interface{}{})
+	}
 
 	log.Printf("[INFO] [LARAVELFORGE:resourceSiteRead] End")
 
@@ -143,7 +269,7 @@ func resourceSiteUpdate(ctx context.Context, d *schema.ResourceData, m interface
 			Wildcards: d.Get("wildcards").(bool),
 		}
 
-		_, err := client.UpdateSite(serverID, siteID, siteUpdates)
+		_, err := client.UpdateSite(ctx, serverID, siteID, siteUpdates)
 		if err != nil {
 			return err
 		}
@@ -153,7 +279,22 @@ func resourceSiteUpdate(ctx context.Context, d *schema.ResourceData, m interface
 		versionUpdate := lf.SiteUpdatePhpVersion{
 			Version: d.Get("php_version").(string),
 		}
-		_, err := client.UpdateSitePhpVersion(serverID, siteID, versionUpdate)
+		_, err := client.UpdateSitePhpVersion(ctx, serverID, siteID, versionUpdate)
+		if err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("repository") {
+		err := updateSiteRepository(ctx, client, serverID, siteID, d)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Reinstalling the repository turns quick deploy off, so it is enabled again if needed.
+	if d.HasChange("quick_deploy") || (d.HasChange("repository") && d.Get("quick_deploy").(bool)) {
+		err := setSiteQuickDeploy(ctx, client, serverID, siteID, d.Get("quick_deploy").(bool))
 		if err != nil {
 			return err
 		}
@@ -162,6 +303,100 @@ func resourceSiteUpdate(ctx context.Context, d *schema.ResourceData, m interface
 	return resourceSiteRead(ctx, d, m)
 }
 
+func updateSiteRepository(ctx context.Context, client *lf.Client, serverID string, siteID string, d *schema.ResourceData) diag.Diagnostics {
+	o, n := d.GetChange("repository")
+	oldRepository := o.([]interface{})
+	newRepository := n.([]interface{})
+
+	if len(oldRepository) > 0 && len(newRepository) > 0 {
+		oldValues := oldRepository[0].(map[string]interface{})
+		newValues := newRepository[0].(map[string]interface{})
+
+		if oldValues["provider"] == newValues["provider"] && oldValues["repository"] == newValues["repository"] {
+			if oldValues["branch"] != newValues["branch"] {
+				branchUpdate := lf.SiteRepositoryBranchUpdateRequest{
+					Branch: newValues["branch"].(string),
+				}
+				_, err := client.UpdateSiteRepositoryBranch(ctx, serverID, siteID, branchUpdate)
+				if err != nil {
+					return err
+				}
+			}
+
+			return nil
+		}
+	}
+
+	if len(oldRepository) > 0 {
+		err := client.DestroySiteRepository(ctx, serverID, siteID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if len(newRepository) > 0 {
+		return installSiteRepository(ctx, client, serverID, siteID, newRepository[0].(map[string]interface{}))
+	}
+
+	return nil
+}
+
+func setSiteQuickDeploy(ctx context.Context, client *lf.Client, serverID string, siteID string, enabled bool) diag.Diagnostics {
+	if enabled {
+		return client.EnableQuickDeploy(ctx, serverID, siteID)
+	}
+
+	return client.DisableQuickDeploy(ctx, serverID, siteID)
+}
+
+func installSiteRepository(ctx context.Context, client *lf.Client, serverId string, siteId string, repository map[string]interface{}) diag.Diagnostics {
+	opts := &lf.SiteRepositoryInstallRequest{
+		Provider:   repository["provider"].(string),
+		Repository: repository["repository"].(string),
+		Branch:     repository["branch"].(string),
+		Composer:   repository["composer"].(bool),
+	}
+
+	log.Printf("[DEBUG] Site repository configuration: %#v", opts)
+
+	site, err := client.InstallSiteRepository(ctx, serverId, siteId, opts)
+	if err != nil {
+		return err
+	}
+
+	attempts := 0
+
+	// Wait for repository status to be other than "installing".
+	for shouldCheck := true; shouldCheck; shouldCheck = site.RepositoryStatus == "installing" {
+		var getErr error
+		site, getErr = client.GetSite(ctx, serverId, siteId)
+		log.Printf("[INFO] [LARAVELFORGE] Site repository waiting: %#v", site)
+
+		if getErr != nil {
+			return diag.FromErr(getErr)
+		}
+
+		if site.RepositoryStatus == "installed" {
+			break
+		}
+
+		if site.RepositoryStatus != "installing" {
+			return diag.Errorf("Unable to install repository. Status: %s.", site.RepositoryStatus)
+		}
+
+		if attempts > 60 {
+			return diag.Errorf("Unable to install repository. Timeout.")
+		}
+
+		if err := lf.SleepContext(ctx, time.Second*10); err != nil {
+			return diag.FromErr(err)
+		}
+		attempts++
+	}
+
+	return nil
+}
+
 func resourceSiteDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(*lf.Client)
 
@@ -169,7 +404,7 @@ func resourceSiteDelete(ctx context.Context, d *schema.ResourceData, m interface
 
 	siteId := d.Id()
 
-	err := c.DeleteSite(d.Get("server_id").(string), siteId)
+	err := c.DeleteSite(ctx, d.Get("server_id").(string), siteId)
 	if err != nil {
 		return diag.FromErr(err)
 	}
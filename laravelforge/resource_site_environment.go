@@ -0,0 +1,294 @@
+package laravelforge
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"log"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	lf "tonning/terraform-provider-laravelforge/client"
+)
+
+func resourceSiteEnvironment() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSiteEnvironmentCreate,
+		ReadContext:   resourceSiteEnvironmentRead,
+		UpdateContext: resourceSiteEnvironmentUpdate,
+		DeleteContext: resourceSiteEnvironmentDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importSiteSetting,
+		},
+		Schema: map[string]*schema.Schema{
+			"server_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"site_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"content": {
+				Type:         schema.TypeString,
+				Description:  "The full content of the `.env` file. Replaces the existing file.",
+				Optional:     true,
+				Sensitive:    true,
+				ExactlyOneOf: []string{"content", "variables"},
+				StateFunc: func(val any) string {
+					return normalizeLineEndings(val.(string))
+				},
+			},
+			"variables": {
+				Type:         schema.TypeMap,
+				Description:  "Variables to merge into the existing `.env` file. Keys not declared here are left untouched.",
+				Optional:     true,
+				Sensitive:    true,
+				ExactlyOneOf: []string{"content", "variables"},
+				Elem:         &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceSiteEnvironmentCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] Site Environment creation")
+
+	d.SetId(d.Get("site_id").(string))
+
+	return resourceSiteEnvironmentUpdate(ctx, d, m)
+}
+
+func resourceSiteEnvironmentRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] [LARAVELFORGE:resourceSiteEnvironmentRead] Start")
+	c := m.(*lf.Client)
+
+	var diags diag.Diagnostics
+
+	serverId := d.Get("server_id").(string)
+	siteId := d.Get("site_id").(string)
+
+	content, err := c.GetSiteEnvironment(ctx, serverId, siteId)
+	if err != nil {
+		if lf.IsNotFound(err) {
+			d.SetId("")
+			return diags
+		}
+		return diag.FromErr(err)
+	}
+
+	if _, ok := d.GetOk("variables"); ok {
+		current := readEnvironmentVariables(content)
+		variables := map[string]interface{}{}
+
+		for key := range d.Get("variables").(map[string]interface{}) {
+			if value, ok := current[key]; ok {
+				variables[key] = value
+			}
+		}
+
+		d.Set("variables", variables)
+	} else {
+		d.Set("content", normalizeLineEndings(content))
+	}
+
+	log.Printf("[INFO] [LARAVELFORGE:resourceSiteEnvironmentRead] End")
+
+	return diags
+}
+
+func resourceSiteEnvironmentUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*lf.Client)
+	serverId := d.Get("server_id").(string)
+	siteId := d.Get("site_id").(string)
+
+	content := normalizeLineEndings(d.Get("content").(string))
+
+	if _, ok := d.GetOk("variables"); ok {
+		current, err := client.GetSiteEnvironment(ctx, serverId, siteId)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		o, n := d.GetChange("variables")
+		variables := map[string]string{}
+		for key, value := range n.(map[string]interface{}) {
+			variables[key] = value.(string)
+		}
+
+		var removed []string
+		for key := range o.(map[string]interface{}) {
+			if _, ok := variables[key]; !ok {
+				removed = append(removed, key)
+			}
+		}
+
+		content = mergeEnvironmentVariables(current, variables, removed)
+	}
+
+	environmentUpdate := lf.EnvironmentUpdateRequest{
+		Content: content,
+	}
+
+	err := client.UpdateSiteEnvironment(ctx, serverId, siteId, environmentUpdate)
+	if err != nil {
+		return err
+	}
+
+	return resourceSiteEnvironmentRead(ctx, d, m)
+}
+
+func resourceSiteEnvironmentDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*lf.Client)
+
+	var diags diag.Diagnostics
+
+	// Only the variables managed by this resource are removed. A fully managed
+	// file is left in place, as a site can't run without an environment file.
+	if v, ok := d.GetOk("variables"); ok {
+		serverId := d.Get("server_id").(string)
+		siteId := d.Get("site_id").(string)
+
+		current, err := client.GetSiteEnvironment(ctx, serverId, siteId)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		var removed []string
+		for key := range v.(map[string]interface{}) {
+			removed = append(removed, key)
+		}
+
+		environmentUpdate := lf.EnvironmentUpdateRequest{
+			Content: mergeEnvironmentVariables(current, map[string]string{}, removed),
+		}
+
+		updateErr := client.UpdateSiteEnvironment(ctx, serverId, siteId, environmentUpdate)
+		if updateErr != nil {
+			return updateErr
+		}
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+// environmentKey returns the variable name declared on a line of an
+// environment file, or an empty string for blank lines and comments.
+func environmentKey(line string) string {
+	line = strings.TrimSpace(line)
+	if line == "" || strings.HasPrefix(line, "#") {
+		return ""
+	}
+
+	line = strings.TrimPrefix(line, "export ")
+	parts := strings.SplitN(line, "=", 2)
+	if len(parts) != 2 {
+		return ""
+	}
+
+	return strings.TrimSpace(parts[0])
+}
+
+func readEnvironmentVariables(content string) map[string]string {
+	variables := map[string]string{}
+
+	for _, line := range strings.Split(normalizeLineEndings(content), "\n") {
+		key := environmentKey(line)
+		if key == "" {
+			continue
+		}
+
+		variables[key] = environmentValue(strings.SplitN(line, "=", 2)[1])
+	}
+
+	return variables
+}
+
+var environmentCommentRegexp = regexp.MustCompile(`(^|\s)#.*$`)
+
+// environmentValue returns the value written after the equals sign of a
+// variable, without its quotes or a trailing comment.
+func environmentValue(value string) string {
+	value = strings.TrimSpace(value)
+
+	switch {
+	case strings.HasPrefix(value, "\""):
+		for i := 1; i < len(value); i++ {
+			if value[i] == '\\' {
+				i++
+			} else if value[i] == '"' {
+				if unquoted, err := strconv.Unquote(value[:i+1]); err == nil {
+					return unquoted
+				}
+				break
+			}
+		}
+	case strings.HasPrefix(value, "'"):
+		if end := strings.Index(value[1:], "'"); end >= 0 {
+			return value[1 : end+1]
+		}
+	default:
+		value = strings.TrimSpace(environmentCommentRegexp.ReplaceAllString(value, ""))
+	}
+
+	return value
+}
+
+func formatEnvironmentValue(value string) string {
+	if strings.ContainsAny(value, " \t#\"'\\$") {
+		return strconv.Quote(value)
+	}
+
+	return value
+}
+
+// mergeEnvironmentVariables sets the given variables in an environment file,
+// replacing existing lines in place and appending new variables, and removes
+// the variables listed in unset.
+func mergeEnvironmentVariables(content string, variables map[string]string, unset []string) string {
+	remove := map[string]bool{}
+	for _, key := range unset {
+		remove[key] = true
+	}
+
+	written := map[string]bool{}
+	var lines []string
+
+	for _, line := range strings.Split(normalizeLineEndings(content), "\n") {
+		key := environmentKey(line)
+
+		if value, ok := variables[key]; ok && key != "" {
+			if !written[key] {
+				lines = append(lines, fmt.Sprintf("%s=%s", key, formatEnvironmentValue(value)))
+				written[key] = true
+			}
+			continue
+		}
+
+		if remove[key] && key != "" {
+			continue
+		}
+
+		lines = append(lines, line)
+	}
+
+	var keys []string
+	for key := range variables {
+		if !written[key] {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		lines = append(lines, fmt.Sprintf("%s=%s", key, formatEnvironmentValue(variables[key])))
+	}
+
+	return strings.TrimLeft(strings.Join(lines, "\n"), "\n") + "\n"
+}
@@ -0,0 +1,164 @@
+package laravelforge
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestReadEnvironmentVariables(t *testing.T) {
+	cases := []struct {
+		name     string
+		content  string
+		expected map[string]string
+	}{
+		{
+			name:     "plain values",
+			content:  "APP_NAME=Laravel\nAPP_ENV=production\n",
+			expected: map[string]string{"APP_NAME": "Laravel", "APP_ENV": "production"},
+		},
+		{
+			name:     "blank lines and comments",
+			content:  "# Application\n\nAPP_NAME=Laravel\n  # APP_ENV=local\n",
+			expected: map[string]string{"APP_NAME": "Laravel"},
+		},
+		{
+			name:     "windows line endings",
+			content:  "APP_NAME=Laravel\r\nAPP_ENV=production\r\n",
+			expected: map[string]string{"APP_NAME": "Laravel", "APP_ENV": "production"},
+		},
+		{
+			name:     "export prefix",
+			content:  "export APP_KEY=base64:abc=\n",
+			expected: map[string]string{"APP_KEY": "base64:abc="},
+		},
+		{
+			name:     "empty value",
+			content:  "MAIL_PASSWORD=\n",
+			expected: map[string]string{"MAIL_PASSWORD": ""},
+		},
+		{
+			name:     "double quoted value",
+			content:  "APP_NAME=\"My App\"\nGREETING=\"say \\\"hi\\\"\"\n",
+			expected: map[string]string{"APP_NAME": "My App", "GREETING": "say \"hi\""},
+		},
+		{
+			name:     "single quoted value",
+			content:  "DB_PASSWORD='p#ss word'\n",
+			expected: map[string]string{"DB_PASSWORD": "p#ss word"},
+		},
+		{
+			name:     "inline comment",
+			content:  "APP_DEBUG=true # enabled while testing\nAPP_URL=https://example.com/#home\n",
+			expected: map[string]string{"APP_DEBUG": "true", "APP_URL": "https://example.com/#home"},
+		},
+		{
+			name:     "inline comment after quoted value",
+			content:  "APP_NAME=\"My App\" # shown in mails\nDB_PASSWORD='secret' # rotated\n",
+			expected: map[string]string{"APP_NAME": "My App", "DB_PASSWORD": "secret"},
+		},
+		{
+			name:     "comment only value",
+			content:  "MAIL_FROM= # set per site\n",
+			expected: map[string]string{"MAIL_FROM": ""},
+		},
+		{
+			name:     "duplicate keys",
+			content:  "APP_ENV=local\nAPP_ENV=production\n",
+			expected: map[string]string{"APP_ENV": "production"},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			actual := readEnvironmentVariables(c.content)
+			if !reflect.DeepEqual(actual, c.expected) {
+				t.Errorf("expected %#v, got %#v", c.expected, actual)
+			}
+		})
+	}
+}
+
+func TestMergeEnvironmentVariables(t *testing.T) {
+	cases := []struct {
+		name      string
+		content   string
+		variables map[string]string
+		unset     []string
+		expected  string
+	}{
+		{
+			name:      "replaces in place",
+			content:   "# Application\nAPP_NAME=Laravel\nAPP_ENV=local\n\nDB_HOST=127.0.0.1\n",
+			variables: map[string]string{"APP_ENV": "production"},
+			expected:  "# Application\nAPP_NAME=Laravel\nAPP_ENV=production\n\nDB_HOST=127.0.0.1\n",
+		},
+		{
+			name:      "appends new variables sorted",
+			content:   "APP_NAME=Laravel\n",
+			variables: map[string]string{"REDIS_HOST": "redis", "CACHE_DRIVER": "redis"},
+			expected:  "APP_NAME=Laravel\nCACHE_DRIVER=redis\nREDIS_HOST=redis\n",
+		},
+		{
+			name:     "removes unset variables",
+			content:  "APP_NAME=Laravel\nSENTRY_DSN=https://sentry.example.com\nAPP_ENV=local\n",
+			unset:    []string{"SENTRY_DSN"},
+			expected: "APP_NAME=Laravel\nAPP_ENV=local\n",
+		},
+		{
+			name:      "collapses duplicate keys",
+			content:   "APP_ENV=local\nAPP_NAME=Laravel\nAPP_ENV=staging\n",
+			variables: map[string]string{"APP_ENV": "production"},
+			expected:  "APP_ENV=production\nAPP_NAME=Laravel\n",
+		},
+		{
+			name:      "replaces exported variables",
+			content:   "export APP_KEY=old\n",
+			variables: map[string]string{"APP_KEY": "new"},
+			expected:  "APP_KEY=new\n",
+		},
+		{
+			name:      "quotes values that need it",
+			content:   "",
+			variables: map[string]string{"APP_NAME": "My App", "MAIL_FROM_NAME": "${APP_NAME}", "TOKEN": "a#b"},
+			expected:  "APP_NAME=\"My App\"\nMAIL_FROM_NAME=\"${APP_NAME}\"\nTOKEN=\"a#b\"\n",
+		},
+		{
+			name:      "leaves comments with matching names",
+			content:   "# APP_ENV=local\nAPP_ENV=staging\n",
+			variables: map[string]string{"APP_ENV": "production"},
+			expected:  "# APP_ENV=local\nAPP_ENV=production\n",
+		},
+		{
+			name:      "normalizes line endings",
+			content:   "APP_NAME=Laravel\r\nAPP_ENV=local",
+			variables: map[string]string{"APP_ENV": "production"},
+			expected:  "APP_NAME=Laravel\nAPP_ENV=production\n",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			actual := mergeEnvironmentVariables(c.content, c.variables, c.unset)
+			if actual != c.expected {
+				t.Errorf("expected %q, got %q", c.expected, actual)
+			}
+		})
+	}
+}
+
+func TestMergedEnvironmentVariablesReadBack(t *testing.T) {
+	variables := map[string]string{
+		"APP_NAME":    "My App",
+		"DB_PASSWORD": "p#ss \"word\" 'quoted'",
+		"PATH_SUFFIX": "C:\\laravel",
+		"APP_DEBUG":   "false",
+	}
+
+	actual := readEnvironmentVariables(mergeEnvironmentVariables("APP_ENV=production\n", variables, nil))
+
+	for key, value := range variables {
+		if actual[key] != value {
+			t.Errorf("expected %s to be %q, got %q", key, value, actual[key])
+		}
+	}
+}
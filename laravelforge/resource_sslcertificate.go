@@ -132,7 +132,7 @@ func resourceSslCertificateCreate(ctx context.Context, d *schema.ResourceData, m
 
 		log.Printf("[DEBUG] SSL Certificate create configuration: %#v", opts)
 
-		certificate, err = client.ObtainLetsEncryptSslCertificate(serverId, siteId, opts)
+		certificate, err = client.ObtainLetsEncryptSslCertificate(ctx, serverId, siteId, opts)
 		log.Printf("[DEBUG] SSL Certificate creation LETSENCRYPT: %#v, Server ID: %s, Site ID: %s", certificate, serverId, siteId)
 	} else if certificateType == "clone" {
 		opts := &lf.SslCertificateCloneRequest{
@@ -141,7 +141,7 @@ func resourceSslCertificateCreate(ctx context.Context, d *schema.ResourceData, m
 		}
 		log.Printf("[DEBUG] SSL Certificate create configuration CLONE: %#v", opts)
 
-		certificate, err = client.CloneExistingSslCertificate(serverId, siteId, opts)
+		certificate, err = client.CloneExistingSslCertificate(ctx, serverId, siteId, opts)
 		log.Printf("[DEBUG] SSL Certificate creation CLONE: %#v, Server ID: %s, Site ID: %s", certificate, serverId, siteId)
 	} else if certificateType == "existing" {
 		opts := &lf.SslCertificateInstallExistingRequest{
@@ -151,7 +151,7 @@ func resourceSslCertificateCreate(ctx context.Context, d *schema.ResourceData, m
 		}
 		log.Printf("[DEBUG] SSL Certificate create configuration CLONE: %#v", opts)
 
-		certificate, err = client.InstallExistingSslCertificate(serverId, siteId, opts)
+		certificate, err = client.InstallExistingSslCertificate(ctx, serverId, siteId, opts)
 		d.Set("type", "existing")
 		d.Set("certificate", d.Get("certificate"))
 		d.Set("key", d.Get("key"))
@@ -169,7 +169,7 @@ func resourceSslCertificateCreate(ctx context.Context, d *schema.ResourceData, m
 
 	// Wait for status to be other than "installing".
 	for shouldCheck := true; shouldCheck; shouldCheck = certificate.Status == "installing" {
-		certificate, err := client.GetCertificate(serverId, siteId, strconv.Itoa(certificateId))
+		certificate, err := client.GetCertificate(ctx, serverId, siteId, strconv.Itoa(certificateId))
 		log.Printf("[INFO] [LARAVELFORGE] SSL Certificate waiting: %#v", certificate)
 
 		if err != nil {
@@ -185,7 +185,9 @@ func resourceSslCertificateCreate(ctx context.Context, d *schema.ResourceData, m
 			return diag.Errorf("Unable to install SSL certificate. Timeout.")
 		}
 
-		time.Sleep(time.Second * 10)
+		if err := lf.SleepContext(ctx, time.Second*10); err != nil {
+			return diag.FromErr(err)
+		}
 		attempts++
 	}
 
@@ -195,12 +197,12 @@ func resourceSslCertificateCreate(ctx context.Context, d *schema.ResourceData, m
 
 	if d.Get("activate").(bool) == true {
 		// Wait for status to be other than "installing".
-		client.ActivateCertificate(serverId, siteId, strconv.Itoa(certificateId))
+		client.ActivateCertificate(ctx, serverId, siteId, strconv.Itoa(certificateId))
 
 		attempts = 0
 
 		for shouldCheck := true; shouldCheck; shouldCheck = certificate.Active == true {
-			certificate, err := client.GetCertificate(serverId, siteId, strconv.Itoa(certificateId))
+			certificate, err := client.GetCertificate(ctx, serverId, siteId, strconv.Itoa(certificateId))
 			log.Printf("[INFO] [LARAVELFORGE] SSL Activation waiting: %#v", certificate)
 
 			if err != nil {
@@ -215,7 +217,9 @@ func resourceSslCertificateCreate(ctx context.Context, d *schema.ResourceData, m
 				return diag.Errorf("Unable to activate SSL certificate. Timeout.")
 			}
 
-			time.Sleep(time.Second * 10)
+			if err := lf.SleepContext(ctx, time.Second*10); err != nil {
+				return diag.FromErr(err)
+			}
 			attempts++
 		}
 	}
@@ -261,11 +265,14 @@ func resourceSslCertificateRead(ctx context.Context, d *schema.ResourceData, m i
 
 	log.Printf("[INFO] [LARAVELFORGE:resourceSslCertificateRead] ID: %s Server ID: %s, Site ID: %s", Id, serverId, siteId)
 
-	certificate, err := c.GetCertificate(serverId, siteId, Id)
+	certificate, err := c.GetCertificate(ctx, serverId, siteId, Id)
 	log.Printf("[INFO] [LARAVELFORGE:resourceSslCertificateRead] ID: %s Certificate: %#v", Id, certificate)
 	if err != nil {
-		d.SetId("")
-		return diags
+		if lf.IsNotFound(err) {
+			d.SetId("")
+			return diags
+		}
+		return diag.FromErr(err)
 	}
 
 	d.SetId(strconv.Itoa(certificate.Id))
@@ -309,7 +316,7 @@ func resourceSslCertificateUpdate(ctx context.Context, d *schema.ResourceData, m
 	//		Wildcards: d.Get("wildcards").(bool),
 	//	}
 	//
-	//	_, err := client.UpdateSite(serverID, siteID, siteUpdates)
+	//	_, err := client.UpdateSite(ctx, serverID, siteID, siteUpdates)
 	//	if err != nil {
 	//		return err
 	//	}
@@ -319,7 +326,7 @@ func resourceSslCertificateUpdate(ctx context.Context, d *schema.ResourceData, m
 	//	versionUpdate := lf.SiteUpdatePhpVersion{
 	//		Version: d.Get("php_version").(string),
 	//	}
-	//	_, err := client.UpdateSitePhpVersion(serverID, siteID, versionUpdate)
+	//	_, err := client.UpdateSitePhpVersion(ctx, serverID, siteID, versionUpdate)
 	//	if err != nil {
 	//		return err
 	//	}
@@ -345,7 +352,7 @@ func resourceSslCertificateDelete(ctx context.Context, d *schema.ResourceData, m
 	siteId := d.Get("site_id").(string)
 	certificateId := d.Id()
 
-	err := c.DeleteCertificate(serverId, siteId, certificateId)
+	err := c.DeleteCertificate(ctx, serverId, siteId, certificateId)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -0,0 +1,121 @@
+package laravelforge
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"log"
+	"strconv"
+	lf "tonning/terraform-provider-laravelforge/client"
+)
+
+func resourceWebhook() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceWebhookCreate,
+		ReadContext:   resourceWebhookRead,
+		DeleteContext: resourceWebhookDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importSiteResource,
+		},
+		Schema: map[string]*schema.Schema{
+			"server_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"site_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"url": {
+				Type:         schema.TypeString,
+				Description:  "The URL Forge notifies when a deployment finishes.",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceWebhookCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*lf.Client)
+
+	var diags diag.Diagnostics
+
+	log.Printf("[DEBUG] Webhook creation")
+	opts := &lf.CreateWebhookRequest{
+		Url: d.Get("url").(string),
+	}
+
+	serverId := d.Get("server_id").(string)
+	siteId := d.Get("site_id").(string)
+
+	webhook, err := client.CreateWebhook(ctx, serverId, siteId, opts)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] [LARAVELFORGE] Webhook response: %#v", webhook)
+	d.SetId(strconv.Itoa(webhook.Id))
+	log.Printf("[INFO] [LARAVELFORGE] Webhook ID: %s", strconv.Itoa(webhook.Id))
+
+	resourceWebhookRead(ctx, d, m)
+
+	return diags
+}
+
+func resourceWebhookRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] [LARAVELFORGE:resourceWebhookRead] Start")
+	c := m.(*lf.Client)
+
+	var diags diag.Diagnostics
+
+	serverId := d.Get("server_id").(string)
+	siteId := d.Get("site_id").(string)
+	webhookId := d.Id()
+
+	webhook, err := c.GetWebhook(ctx, serverId, siteId, webhookId)
+	log.Printf("[INFO] [LARAVELFORGE:resourceWebhookRead] ID: %s Webhook: %#v", webhookId, webhook)
+	if err != nil {
+		if lf.IsNotFound(err) {
+			d.SetId("")
+			return diags
+		}
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.Itoa(webhook.Id))
+
+	d.Set("url", webhook.Url)
+	d.Set("created_at", webhook.CreatedAt)
+
+	log.Printf("[INFO] [LARAVELFORGE:resourceWebhookRead] End")
+
+	return diags
+}
+
+func resourceWebhookDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*lf.Client)
+
+	var diags diag.Diagnostics
+
+	serverId := d.Get("server_id").(string)
+	siteId := d.Get("site_id").(string)
+	webhookId := d.Id()
+
+	err := c.DeleteWebhook(ctx, serverId, siteId, webhookId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
@@ -0,0 +1,265 @@
+package laravelforge
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"log"
+	"strconv"
+	"time"
+	lf "tonning/terraform-provider-laravelforge/client"
+)
+
+func resourceWorker() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceWorkerCreate,
+		ReadContext:   resourceWorkerRead,
+		UpdateContext: resourceWorkerUpdate,
+		DeleteContext: resourceWorkerDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importSiteResource,
+		},
+		Schema: map[string]*schema.Schema{
+			"server_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"site_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"queue_connection": {
+				Type:        schema.TypeString,
+				Description: "The queue connection to process, i.e. `redis`.",
+				Optional:    true,
+				Default:     "redis",
+				ForceNew:    true,
+			},
+			"queue": {
+				Type:        schema.TypeString,
+				Description: "A comma separated list of queues to process.",
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+			"timeout": {
+				Type:        schema.TypeInt,
+				Description: "The number of seconds a job may run before timing out.",
+				Optional:    true,
+				Default:     60,
+				ForceNew:    true,
+			},
+			"sleep": {
+				Type:        schema.TypeInt,
+				Description: "The number of seconds to sleep when no job is available.",
+				Optional:    true,
+				Default:     10,
+				ForceNew:    true,
+			},
+			"delay": {
+				Type:        schema.TypeInt,
+				Description: "The number of seconds to delay failed jobs before retrying them.",
+				Optional:    true,
+				Default:     0,
+				ForceNew:    true,
+			},
+			"tries": {
+				Type:        schema.TypeInt,
+				Description: "The maximum number of times a job may be attempted.",
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"processes": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  1,
+				ForceNew: true,
+			},
+			"daemon": {
+				Type:        schema.TypeBool,
+				Description: "Whether to run the worker in daemon mode.",
+				Optional:    true,
+				Default:     false,
+				ForceNew:    true,
+			},
+			"force": {
+				Type:        schema.TypeBool,
+				Description: "Whether to process jobs while the application is in maintenance mode.",
+				Optional:    true,
+				Default:     false,
+				ForceNew:    true,
+			},
+			"php_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"restart_on_change": {
+				Type:        schema.TypeMap,
+				Description: "Arbitrary map of values that, when changed, will restart the worker.",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"command": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceWorkerCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*lf.Client)
+
+	var diags diag.Diagnostics
+
+	log.Printf("[DEBUG] Worker creation")
+	opts := &lf.CreateWorkerRequest{
+		Connection: d.Get("queue_connection").(string),
+		Queue:      d.Get("queue").(string),
+		Timeout:    d.Get("timeout").(int),
+		Sleep:      d.Get("sleep").(int),
+		Delay:      d.Get("delay").(int),
+		Tries:      d.Get("tries").(int),
+		Processes:  d.Get("processes").(int),
+		Daemon:     d.Get("daemon").(bool),
+		Force:      d.Get("force").(bool),
+		PhpVersion: d.Get("php_version").(string),
+	}
+
+	log.Printf("[DEBUG] Worker configuration: %#v", opts)
+
+	serverId := d.Get("server_id").(string)
+	siteId := d.Get("site_id").(string)
+
+	worker, err := client.CreateWorker(ctx, serverId, siteId, opts)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.Itoa(worker.Id))
+	log.Printf("[INFO] [LARAVELFORGE] Worker ID: %s", d.Id())
+
+	attempts := 0
+
+	// Wait for status to be other than "installing".
+	for shouldCheck := true; shouldCheck; shouldCheck = worker.Status == "installing" {
+		var getErr error
+		worker, getErr = client.GetWorker(ctx, serverId, siteId, d.Id())
+		log.Printf("[INFO] [LARAVELFORGE] Worker waiting: %#v", worker)
+
+		if getErr != nil {
+			return diag.FromErr(getErr)
+		}
+
+		if worker.Status == "installed" || worker.Status == "running" {
+			break
+		}
+
+		if worker.Status != "installing" {
+			return diag.Errorf("Unable to add worker. Status: %s.", worker.Status)
+		}
+
+		if attempts > 10 {
+			return diag.Errorf("Unable to add worker. Timeout.")
+		}
+
+		if err := lf.SleepContext(ctx, time.Second*5); err != nil {
+			return diag.FromErr(err)
+		}
+		attempts++
+	}
+
+	log.Printf("[INFO] [LARAVELFORGE] Worker response: %#v", worker)
+
+	resourceWorkerRead(ctx, d, m)
+
+	return diags
+}
+
+func resourceWorkerRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] [LARAVELFORGE:resourceWorkerRead] Start")
+	c := m.(*lf.Client)
+
+	var diags diag.Diagnostics
+
+	serverId := d.Get("server_id").(string)
+	siteId := d.Get("site_id").(string)
+	workerId := d.Id()
+
+	worker, err := c.GetWorker(ctx, serverId, siteId, workerId)
+	log.Printf("[INFO] [LARAVELFORGE:resourceWorkerRead] ID: %s Worker: %#v", workerId, worker)
+	if err != nil {
+		if lf.IsNotFound(err) {
+			d.SetId("")
+			return diags
+		}
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.Itoa(worker.Id))
+
+	d.Set("queue_connection", worker.Connection)
+	d.Set("queue", worker.Queue)
+	d.Set("timeout", worker.Timeout)
+	d.Set("sleep", worker.Sleep)
+	d.Set("delay", worker.Delay)
+	d.Set("tries", worker.Tries)
+	d.Set("processes", worker.Processes)
+	d.Set("daemon", bool(worker.Daemon))
+	d.Set("force", bool(worker.Force))
+	d.Set("php_version", worker.PhpVersion)
+	d.Set("command", worker.Command)
+	d.Set("status", worker.Status)
+	d.Set("created_at", worker.CreatedAt)
+
+	log.Printf("[INFO] [LARAVELFORGE:resourceWorkerRead] End")
+
+	return diags
+}
+
+func resourceWorkerUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*lf.Client)
+	workerId := d.Id()
+	serverId := d.Get("server_id").(string)
+	siteId := d.Get("site_id").(string)
+
+	if d.HasChange("restart_on_change") {
+		err := client.RestartWorker(ctx, serverId, siteId, workerId)
+		if err != nil {
+			return err
+		}
+	}
+
+	return resourceWorkerRead(ctx, d, m)
+}
+
+func resourceWorkerDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*lf.Client)
+
+	var diags diag.Diagnostics
+
+	serverId := d.Get("server_id").(string)
+	siteId := d.Get("site_id").(string)
+	workerId := d.Id()
+
+	err := c.DeleteWorker(ctx, serverId, siteId, workerId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return diags
+}